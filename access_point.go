@@ -0,0 +1,98 @@
+package ups
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Shipment indication types of ShipmentIndicationType.Code.
+const (
+	ShipmentIndicationTypeHoldForPickup       = "01"
+	ShipmentIndicationTypeAccessPointDelivery = "02"
+)
+
+// IsAccessPointDelivery reports whether the shipment is delivered to a UPS
+// Access Point.
+func (s *Shipment) IsAccessPointDelivery() bool {
+	if s.ShipmentIndicationType == nil {
+		return false
+	}
+
+	code := s.ShipmentIndicationType.Code
+
+	return code == ShipmentIndicationTypeHoldForPickup || code == ShipmentIndicationTypeAccessPointDelivery
+}
+
+// hasNotification reports whether a shipment level notification with code
+// is requested.
+func (s *Shipment) hasNotification(code NotificationCode) bool {
+	if s.ShipmentServiceOptions == nil {
+		return false
+	}
+
+	return slices.ContainsFunc(s.ShipmentServiceOptions.Notifications, func(n Notification) bool {
+		return NotificationCode(n.NotificationCode) == code
+	})
+}
+
+func (s *Shipment) validateAccessPoint() []error {
+	var errs []error
+
+	indicationType := ""
+	if s.ShipmentIndicationType != nil {
+		indicationType = s.ShipmentIndicationType.Code
+
+		if !s.IsAccessPointDelivery() {
+			errs = append(errs, newValidationError("Shipment.ShipmentIndicationType.Code", "%q is not valid, use 01 or 02", indicationType))
+		}
+	}
+
+	if s.IsAccessPointDelivery() {
+		if a := s.AlternateDeliveryAddress; a == nil {
+			errs = append(errs, newValidationError("Shipment.AlternateDeliveryAddress", "is required for shipment indication type %s", indicationType))
+		} else if a.UPSAccessPointID == "" {
+			errs = append(errs, newValidationError("Shipment.AlternateDeliveryAddress.UPSAccessPointID", "is required for shipment indication type %s", indicationType))
+		}
+
+		if !s.hasNotification(NotificationCodeAlternateDeliveryLocation) {
+			errs = append(errs, newValidationError("Shipment.ShipmentServiceOptions.Notification", "alternate delivery location notification %s is required for shipment indication type %s",
+				NotificationCodeAlternateDeliveryLocation, indicationType))
+		}
+
+		if s.IsReturn() {
+			errs = append(errs, newValidationError("Shipment.ShipmentIndicationType", "not valid for return shipments"))
+		}
+	} else {
+		if s.AlternateDeliveryAddress != nil {
+			errs = append(errs, newValidationError("Shipment.AlternateDeliveryAddress", "requires shipment indication type 01 or 02"))
+		}
+
+		for _, code := range []NotificationCode{NotificationCodeAlternateDeliveryLocation, NotificationCodeUAPShipper} {
+			if s.hasNotification(code) {
+				errs = append(errs, newValidationError("Shipment.ShipmentServiceOptions.Notification", "notification %s requires shipment indication type 01 or 02", code))
+			}
+		}
+	}
+
+	if options := s.ShipmentServiceOptions; options != nil {
+		if options.DirectDeliveryOnlyIndicator != "" && s.IsAccessPointDelivery() {
+			errs = append(errs, newValidationError("Shipment.ShipmentServiceOptions.DirectDeliveryOnlyIndicator", "not valid with shipment indication type %s", indicationType))
+		}
+
+		if options.DeliverToAddresseeOnlyIndicator != "" && indicationType != ShipmentIndicationTypeHoldForPickup {
+			errs = append(errs, newValidationError("Shipment.ShipmentServiceOptions.DeliverToAddresseeOnlyIndicator", "only valid with shipment indication type 01"))
+		}
+
+		if options.AccessPointCOD != nil && indicationType != ShipmentIndicationTypeHoldForPickup {
+			errs = append(errs, newValidationError("Shipment.ShipmentServiceOptions.AccessPointCOD", "only valid with shipment indication type 01"))
+		}
+	}
+
+	for i, p := range s.Packages {
+		if p.PackageServiceOptions != nil && p.PackageServiceOptions.AccessPointCOD != nil && indicationType != ShipmentIndicationTypeHoldForPickup {
+			errs = append(errs, newValidationError(fmt.Sprintf("Shipment.Package[%d].PackageServiceOptions.AccessPointCOD", i), "only valid with shipment indication type 01"))
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,136 @@
+package ups
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateAccessPoint(t *testing.T) {
+	accessPoint := &AlternateDeliveryAddress{Name: "Kiosk", UPSAccessPointID: "U12345678"}
+	email := EMail{EMailAddresses: []string{"receiver@example.com"}}
+	locationNotification := Notification{NotificationCode: string(NotificationCodeAlternateDeliveryLocation), EMail: email}
+	shipperNotification := Notification{NotificationCode: string(NotificationCodeUAPShipper), EMail: email}
+	accessPointCOD := &AccessPointCOD{CurrencyCode: "EUR", MonetaryValue: "100"}
+
+	tests := []struct {
+		name           string
+		indicationType string
+		address        *AlternateDeliveryAddress
+		returns        bool
+		options        *ShipmentServiceOptions
+		packageCOD     *AccessPointCOD
+		want           []string
+	}{
+		{
+			name:           "hold for pickup",
+			indicationType: ShipmentIndicationTypeHoldForPickup,
+			address:        accessPoint,
+			options: &ShipmentServiceOptions{
+				Notifications:                   []Notification{locationNotification, shipperNotification},
+				DeliverToAddresseeOnlyIndicator: " ",
+				AccessPointCOD:                  accessPointCOD,
+			},
+			packageCOD: accessPointCOD,
+		},
+		{
+			name:           "access point delivery without address and notification",
+			indicationType: ShipmentIndicationTypeAccessPointDelivery,
+			want:           []string{"Shipment.AlternateDeliveryAddress", "Shipment.ShipmentServiceOptions.Notification"},
+		},
+		{
+			name:           "access point delivery without access point ID",
+			indicationType: ShipmentIndicationTypeAccessPointDelivery,
+			address:        &AlternateDeliveryAddress{Name: "Kiosk"},
+			options:        &ShipmentServiceOptions{Notifications: []Notification{locationNotification}},
+			want:           []string{"Shipment.AlternateDeliveryAddress.UPSAccessPointID"},
+		},
+		{
+			name:           "return shipment",
+			indicationType: ShipmentIndicationTypeHoldForPickup,
+			address:        accessPoint,
+			returns:        true,
+			options:        &ShipmentServiceOptions{Notifications: []Notification{locationNotification}},
+			want:           []string{"Shipment.ShipmentIndicationType"},
+		},
+		{
+			name:           "direct delivery only",
+			indicationType: ShipmentIndicationTypeAccessPointDelivery,
+			address:        accessPoint,
+			options: &ShipmentServiceOptions{
+				Notifications:               []Notification{locationNotification},
+				DirectDeliveryOnlyIndicator: " ",
+			},
+			want: []string{"Shipment.ShipmentServiceOptions.DirectDeliveryOnlyIndicator"},
+		},
+		{
+			name:           "invalid indication type",
+			indicationType: "03",
+			address:        accessPoint,
+			want:           []string{"Shipment.ShipmentIndicationType.Code", "Shipment.AlternateDeliveryAddress"},
+		},
+		{
+			name:    "access point notifications without indication type",
+			options: &ShipmentServiceOptions{Notifications: []Notification{locationNotification, shipperNotification}},
+			want:    []string{"Shipment.ShipmentServiceOptions.Notification", "Shipment.ShipmentServiceOptions.Notification"},
+		},
+		{
+			name:    "direct delivery only without indication type",
+			options: &ShipmentServiceOptions{DirectDeliveryOnlyIndicator: " "},
+		},
+		{
+			name:           "access point COD with access point delivery",
+			indicationType: ShipmentIndicationTypeAccessPointDelivery,
+			address:        accessPoint,
+			options: &ShipmentServiceOptions{
+				Notifications:                   []Notification{locationNotification},
+				DeliverToAddresseeOnlyIndicator: " ",
+				AccessPointCOD:                  accessPointCOD,
+			},
+			packageCOD: accessPointCOD,
+			want: []string{
+				"Shipment.ShipmentServiceOptions.DeliverToAddresseeOnlyIndicator",
+				"Shipment.ShipmentServiceOptions.AccessPointCOD",
+				"Shipment.Package[0].PackageServiceOptions.AccessPointCOD",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := testShipment("DE", "DE")
+			s.AlternateDeliveryAddress = tt.address
+			s.ShipmentServiceOptions = tt.options
+			s.Packages = []Package{{PackageServiceOptions: &PackageServiceOptions{AccessPointCOD: tt.packageCOD}}}
+
+			if tt.indicationType != "" {
+				s.ShipmentIndicationType = &ShipmentIndicationType{Code: tt.indicationType}
+			}
+
+			if tt.returns {
+				s.ReturnService = &ReturnService{Code: ReturnServiceThreeAttempt}
+			}
+
+			checkValidationFields(t, errors.Join(s.validateAccessPoint()...), tt.want)
+		})
+	}
+}
+
+func TestIsAccessPointDelivery(t *testing.T) {
+	tests := []struct {
+		indicationType *ShipmentIndicationType
+		want           bool
+	}{
+		{nil, false},
+		{&ShipmentIndicationType{Code: ShipmentIndicationTypeHoldForPickup}, true},
+		{&ShipmentIndicationType{Code: ShipmentIndicationTypeAccessPointDelivery}, true},
+		{&ShipmentIndicationType{Code: "03"}, false},
+	}
+
+	for _, tt := range tests {
+		s := Shipment{ShipmentIndicationType: tt.indicationType}
+
+		if got := s.IsAccessPointDelivery(); got != tt.want {
+			t.Errorf("IsAccessPointDelivery() with %+v = %t, want %t", tt.indicationType, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,252 @@
+package ups
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"slices"
+	"strings"
+)
+
+// Dimensional weight divisors of UPS. The retail divisors apply to retail
+// rates of domestic US shipments, all other shipments use the daily rate
+// divisors.
+const (
+	DimDivisorDailyIN  = 139
+	DimDivisorRetailIN = 166
+	DimDivisorDailyCM  = 5000
+	DimDivisorRetailCM = 6000
+)
+
+// sizeLimits are the UPS thresholds of a unit system.
+type sizeLimits struct {
+	weightUnit    WeightUnit
+	dimensionUnit DimensionUnit
+	// weightStep is the step actual and dimensional weights are rounded up
+	// to.
+	weightStep float64
+
+	largePackageLengthAndGirth float64
+	largePackageLength         float64
+	largePackageMinimumWeight  float64
+
+	additionalHandlingLength         float64
+	additionalHandlingWidth          float64
+	additionalHandlingLengthAndGirth float64
+	additionalHandlingWeight         float64
+	oversizeLengthAndGirth           float64
+
+	dailyDivisor  float64
+	retailDivisor float64
+}
+
+var (
+	imperialLimits = sizeLimits{
+		weightUnit:                       WeightUnitPounds,
+		dimensionUnit:                    DimensionUnitInches,
+		weightStep:                       1,
+		largePackageLengthAndGirth:       130,
+		largePackageLength:               96,
+		largePackageMinimumWeight:        90,
+		additionalHandlingLength:         48,
+		additionalHandlingWidth:          30,
+		additionalHandlingLengthAndGirth: 105,
+		additionalHandlingWeight:         50,
+		oversizeLengthAndGirth:           108,
+		dailyDivisor:                     DimDivisorDailyIN,
+		retailDivisor:                    DimDivisorRetailIN,
+	}
+	metricLimits = sizeLimits{
+		weightUnit:                       WeightUnitKilograms,
+		dimensionUnit:                    DimensionUnitCentimeters,
+		weightStep:                       0.5,
+		largePackageLengthAndGirth:       330,
+		largePackageLength:               244,
+		largePackageMinimumWeight:        40,
+		additionalHandlingLength:         122,
+		additionalHandlingWidth:          76,
+		additionalHandlingLengthAndGirth: 267,
+		additionalHandlingWeight:         25,
+		oversizeLengthAndGirth:           274,
+		dailyDivisor:                     DimDivisorDailyCM,
+		retailDivisor:                    DimDivisorRetailCM,
+	}
+)
+
+// oversizeServices contains the services the OversizeIndicator applies to.
+var oversizeServices = []ServiceCode{ServiceWorldwideEconomyDDU}
+
+// BillableWeightOptions are the shipment details the billable weight of a
+// package depends on.
+type BillableWeightOptions struct {
+	// Origin and destination country or territory codes.
+	Origin      string
+	Destination string
+	// Service of the shipment.
+	Service string
+	// Retail is true if retail rates apply instead of daily rates.
+	Retail bool
+}
+
+// BillableWeight is the result of CalculateBillableWeight. All weights are
+// in Unit, which is pounds for packages measured in inches and kilograms for
+// packages measured in centimeters.
+type BillableWeight struct {
+	Unit WeightUnit
+	// Divisor used to calculate the dimensional weight.
+	Divisor float64
+	// ActualWeight is the package weight rounded up.
+	ActualWeight float64
+	// DimensionalWeight is the volume divided by Divisor, rounded up. It is
+	// zero for packages without dimensions and Simple Rate packages.
+	DimensionalWeight float64
+	// BillableWeight is the greater of the actual and dimensional weight,
+	// but at least the minimum billable weight of large packages.
+	BillableWeight float64
+	// LargePackage is true if the length exceeds 96 IN (244 CM) or length
+	// and girth exceed 130 IN (330 CM).
+	LargePackage bool
+	// AdditionalHandling is true if the length exceeds 48 IN (122 CM), the
+	// second longest side exceeds 30 IN (76 CM), length and girth exceed
+	// 105 IN (267 CM) or the weight exceeds 50 LBS (25 KGS). It is never
+	// true for large packages, which are not charged additional handling.
+	AdditionalHandling bool
+	// Oversize is true if length and girth exceed 108 IN (274 CM) for UPS
+	// Worldwide Economy DDU.
+	Oversize bool
+}
+
+// CalculateBillableWeight calculates the dimensional and billable weight of
+// the package and checks the size thresholds of UPS surcharges. The package
+// needs a weight, dimensions or both.
+func CalculateBillableWeight(p *Package, options BillableWeightOptions) (*BillableWeight, error) {
+	hasDimensions := p.Dimensions.Length != "" || p.Dimensions.Width != "" || p.Dimensions.Height != ""
+	if p.PackageWeight == nil && !hasDimensions {
+		return nil, errors.New("package weight or dimensions are required")
+	}
+
+	limits := imperialLimits
+	if hasDimensions && DimensionUnit(p.Dimensions.UnitOfMeasurement.Code).IsMetric() ||
+		!hasDimensions && WeightUnit(p.PackageWeight.UnitOfMeasurement.Code).IsMetric() {
+		limits = metricLimits
+	}
+
+	result := &BillableWeight{
+		Unit:    limits.weightUnit,
+		Divisor: limits.dailyDivisor,
+	}
+
+	origin, destination := strings.ToUpper(options.Origin), strings.ToUpper(options.Destination)
+	if options.Retail && slices.Contains(usOrigins, origin) && isDomestic(origin, destination) {
+		result.Divisor = limits.retailDivisor
+	}
+
+	if p.PackageWeight != nil {
+		weight, err := p.PackageWeight.ValueIn(limits.weightUnit)
+		if err != nil {
+			return nil, err
+		}
+
+		result.ActualWeight = roundUpToStep(weight, limits.weightStep)
+	}
+
+	if hasDimensions {
+		length, width, height, err := p.Dimensions.ValuesIn(limits.dimensionUnit)
+		if err != nil {
+			return nil, err
+		}
+
+		// UPS rounds each side to the nearest whole unit.
+		sides := []float64{math.Round(length), math.Round(width), math.Round(height)}
+		slices.Sort(sides)
+		slices.Reverse(sides)
+
+		if p.SimpleRate == nil {
+			result.DimensionalWeight = roundUpToStep(sides[0]*sides[1]*sides[2]/result.Divisor, limits.weightStep)
+		}
+
+		lengthAndGirth := sides[0] + 2*(sides[1]+sides[2])
+
+		result.LargePackage = sides[0] > limits.largePackageLength || lengthAndGirth > limits.largePackageLengthAndGirth
+		result.AdditionalHandling = sides[0] > limits.additionalHandlingLength || sides[1] > limits.additionalHandlingWidth ||
+			lengthAndGirth > limits.additionalHandlingLengthAndGirth
+		result.Oversize = slices.Contains(oversizeServices, ServiceCode(options.Service)) && lengthAndGirth > limits.oversizeLengthAndGirth
+	}
+
+	if result.ActualWeight > limits.additionalHandlingWeight {
+		result.AdditionalHandling = true
+	}
+
+	if result.LargePackage {
+		result.AdditionalHandling = false
+	}
+
+	result.BillableWeight = max(result.ActualWeight, result.DimensionalWeight)
+	if result.LargePackage {
+		result.BillableWeight = max(result.BillableWeight, limits.largePackageMinimumWeight)
+	}
+
+	return result, nil
+}
+
+// roundUpToStep rounds v up to the next multiple of step.
+func roundUpToStep(v, step float64) float64 {
+	return math.Ceil(v/step-1e-9) * step
+}
+
+// SetIndicators sets LargePackageIndicator, AdditionalHandlingIndicator and
+// OversizeIndicator of the package if the thresholds are crossed. Indicators
+// which are already set are kept, as additional handling can also be
+// required by the packaging.
+func (w *BillableWeight) SetIndicators(p *Package) {
+	if w.LargePackage {
+		p.LargePackageIndicator = " "
+	}
+
+	if w.AdditionalHandling {
+		p.AdditionalHandlingIndicator = " "
+	}
+
+	if w.Oversize {
+		p.OversizeIndicator = " "
+	}
+}
+
+// BillableWeights calculates the billable weight of all packages of the
+// shipment using its origin, destination and service.
+func (s *Shipment) BillableWeights(retail bool) ([]BillableWeight, error) {
+	options := BillableWeightOptions{
+		Origin:      s.originCountryCode(),
+		Destination: s.destinationCountryCode(),
+		Service:     s.Service.Code,
+		Retail:      retail,
+	}
+
+	weights := make([]BillableWeight, len(s.Packages))
+
+	for i := range s.Packages {
+		w, err := CalculateBillableWeight(&s.Packages[i], options)
+		if err != nil {
+			return nil, fmt.Errorf("package %d: %w", i, err)
+		}
+
+		weights[i] = *w
+	}
+
+	return weights, nil
+}
+
+// SetSizeIndicators sets the size indicators of all packages of the shipment
+// using BillableWeight.SetIndicators.
+func (s *Shipment) SetSizeIndicators() error {
+	weights, err := s.BillableWeights(false)
+	if err != nil {
+		return err
+	}
+
+	for i := range weights {
+		weights[i].SetIndicators(&s.Packages[i])
+	}
+
+	return nil
+}
@@ -0,0 +1,201 @@
+package ups
+
+import "testing"
+
+func TestCalculateBillableWeight(t *testing.T) {
+	inches := func(length, width, height string) Dimensions {
+		return Dimensions{UnitOfMeasurement: DimensionsUnitOfMeasurement{Code: string(DimensionUnitInches)}, Length: length, Width: width, Height: height}
+	}
+	centimeters := func(length, width, height string) Dimensions {
+		return Dimensions{UnitOfMeasurement: DimensionsUnitOfMeasurement{Code: string(DimensionUnitCentimeters)}, Length: length, Width: width, Height: height}
+	}
+	weight := func(value string, unit WeightUnit) *PackageWeight {
+		return &PackageWeight{UnitOfMeasurement: PackageWeightUnitOfMeasurement{Code: string(unit)}, Weight: value}
+	}
+
+	tests := []struct {
+		name       string
+		pkg        Package
+		options    BillableWeightOptions
+		want       BillableWeight
+		simpleRate bool
+	}{
+		{
+			name: "dimensional weight",
+			pkg:  Package{Dimensions: inches("20", "20", "20"), PackageWeight: weight("10", WeightUnitPounds)},
+			want: BillableWeight{Unit: WeightUnitPounds, Divisor: DimDivisorDailyIN, ActualWeight: 10, DimensionalWeight: 58, BillableWeight: 58},
+		},
+		{
+			name: "actual weight",
+			pkg:  Package{Dimensions: inches("10", "10", "10"), PackageWeight: weight("20.2", WeightUnitPounds)},
+			want: BillableWeight{Unit: WeightUnitPounds, Divisor: DimDivisorDailyIN, ActualWeight: 21, DimensionalWeight: 8, BillableWeight: 21},
+		},
+		{
+			name:    "retail divisor with lower case countries",
+			pkg:     Package{Dimensions: inches("20", "20", "20")},
+			options: BillableWeightOptions{Origin: "us", Destination: "pr", Retail: true},
+			want:    BillableWeight{Unit: WeightUnitPounds, Divisor: DimDivisorRetailIN, DimensionalWeight: 49, BillableWeight: 49},
+		},
+		{
+			name:    "daily divisor for international retail",
+			pkg:     Package{Dimensions: inches("20", "20", "20")},
+			options: BillableWeightOptions{Origin: "US", Destination: "CA", Retail: true},
+			want:    BillableWeight{Unit: WeightUnitPounds, Divisor: DimDivisorDailyIN, DimensionalWeight: 58, BillableWeight: 58},
+		},
+		{
+			name: "length of 96 in",
+			pkg:  Package{Dimensions: inches("96.4", "1", "1"), PackageWeight: weight("5", WeightUnitPounds)},
+			want: BillableWeight{Unit: WeightUnitPounds, Divisor: DimDivisorDailyIN, ActualWeight: 5, DimensionalWeight: 1, BillableWeight: 5, AdditionalHandling: true},
+		},
+		{
+			name: "length over 96 in",
+			pkg:  Package{Dimensions: inches("97", "1", "1"), PackageWeight: weight("5", WeightUnitPounds)},
+			want: BillableWeight{Unit: WeightUnitPounds, Divisor: DimDivisorDailyIN, ActualWeight: 5, DimensionalWeight: 1, BillableWeight: 90, LargePackage: true},
+		},
+		{
+			name: "length and girth of 130 in",
+			pkg:  Package{Dimensions: inches("40", "23", "22"), PackageWeight: weight("5", WeightUnitPounds)},
+			want: BillableWeight{Unit: WeightUnitPounds, Divisor: DimDivisorDailyIN, ActualWeight: 5, DimensionalWeight: 146, BillableWeight: 146, AdditionalHandling: true},
+		},
+		{
+			name: "length and girth over 130 in",
+			pkg:  Package{Dimensions: inches("40", "23", "23"), PackageWeight: weight("5", WeightUnitPounds)},
+			want: BillableWeight{Unit: WeightUnitPounds, Divisor: DimDivisorDailyIN, ActualWeight: 5, DimensionalWeight: 153, BillableWeight: 153, LargePackage: true},
+		},
+		{
+			name: "large package minimum weight",
+			pkg:  Package{Dimensions: inches("100", "10", "5"), PackageWeight: weight("80", WeightUnitPounds)},
+			want: BillableWeight{Unit: WeightUnitPounds, Divisor: DimDivisorDailyIN, ActualWeight: 80, DimensionalWeight: 36, BillableWeight: 90, LargePackage: true},
+		},
+		{
+			name: "large package over minimum weight",
+			pkg:  Package{Dimensions: inches("100", "10", "5"), PackageWeight: weight("95", WeightUnitPounds)},
+			want: BillableWeight{Unit: WeightUnitPounds, Divisor: DimDivisorDailyIN, ActualWeight: 95, DimensionalWeight: 36, BillableWeight: 95, LargePackage: true},
+		},
+		{
+			name: "length and girth of 105 in",
+			pkg:  Package{Dimensions: inches("41", "16", "16")},
+			want: BillableWeight{Unit: WeightUnitPounds, Divisor: DimDivisorDailyIN, DimensionalWeight: 76, BillableWeight: 76},
+		},
+		{
+			name: "length and girth over 105 in",
+			pkg:  Package{Dimensions: inches("42", "16", "16")},
+			want: BillableWeight{Unit: WeightUnitPounds, Divisor: DimDivisorDailyIN, DimensionalWeight: 78, BillableWeight: 78, AdditionalHandling: true},
+		},
+		{
+			name: "second longest side over 30 in",
+			pkg:  Package{Dimensions: inches("31", "31", "2")},
+			want: BillableWeight{Unit: WeightUnitPounds, Divisor: DimDivisorDailyIN, DimensionalWeight: 14, BillableWeight: 14, AdditionalHandling: true},
+		},
+		{
+			name: "weight of 50 lbs",
+			pkg:  Package{PackageWeight: weight("50", WeightUnitPounds)},
+			want: BillableWeight{Unit: WeightUnitPounds, Divisor: DimDivisorDailyIN, ActualWeight: 50, BillableWeight: 50},
+		},
+		{
+			name: "weight over 50 lbs",
+			pkg:  Package{PackageWeight: weight("50.1", WeightUnitPounds)},
+			want: BillableWeight{Unit: WeightUnitPounds, Divisor: DimDivisorDailyIN, ActualWeight: 51, BillableWeight: 51, AdditionalHandling: true},
+		},
+		{
+			name: "large package over 50 lbs",
+			pkg:  Package{Dimensions: inches("100", "10", "5"), PackageWeight: weight("60", WeightUnitPounds)},
+			want: BillableWeight{Unit: WeightUnitPounds, Divisor: DimDivisorDailyIN, ActualWeight: 60, DimensionalWeight: 36, BillableWeight: 90, LargePackage: true},
+		},
+		{
+			name:    "oversize",
+			pkg:     Package{Dimensions: inches("60", "13", "12")},
+			options: BillableWeightOptions{Service: string(ServiceWorldwideEconomyDDU)},
+			want:    BillableWeight{Unit: WeightUnitPounds, Divisor: DimDivisorDailyIN, DimensionalWeight: 68, BillableWeight: 68, AdditionalHandling: true, Oversize: true},
+		},
+		{
+			name:    "oversize for other service",
+			pkg:     Package{Dimensions: inches("60", "13", "12")},
+			options: BillableWeightOptions{Service: string(ServiceStandard)},
+			want:    BillableWeight{Unit: WeightUnitPounds, Divisor: DimDivisorDailyIN, DimensionalWeight: 68, BillableWeight: 68, AdditionalHandling: true},
+		},
+		{
+			name: "metric steps",
+			pkg:  Package{Dimensions: centimeters("51", "40", "30"), PackageWeight: weight("10.2", WeightUnitKilograms)},
+			want: BillableWeight{Unit: WeightUnitKilograms, Divisor: DimDivisorDailyCM, ActualWeight: 10.5, DimensionalWeight: 12.5, BillableWeight: 12.5},
+		},
+		{
+			name: "metric exact step",
+			pkg:  Package{Dimensions: centimeters("50", "40", "30"), PackageWeight: weight("12.5", WeightUnitKilograms)},
+			want: BillableWeight{Unit: WeightUnitKilograms, Divisor: DimDivisorDailyCM, ActualWeight: 12.5, DimensionalWeight: 12, BillableWeight: 12.5},
+		},
+		{
+			name: "metric weight without dimensions",
+			pkg:  Package{PackageWeight: weight("25.1", WeightUnitKilograms)},
+			want: BillableWeight{Unit: WeightUnitKilograms, Divisor: DimDivisorDailyCM, ActualWeight: 25.5, BillableWeight: 25.5, AdditionalHandling: true},
+		},
+		{
+			name: "metric large package",
+			pkg:  Package{Dimensions: centimeters("245", "20", "10"), PackageWeight: weight("10", WeightUnitKilograms)},
+			want: BillableWeight{Unit: WeightUnitKilograms, Divisor: DimDivisorDailyCM, ActualWeight: 10, DimensionalWeight: 10, BillableWeight: 40, LargePackage: true},
+		},
+		{
+			name:       "simple rate",
+			pkg:        Package{Dimensions: inches("20", "20", "20"), PackageWeight: weight("10", WeightUnitPounds)},
+			simpleRate: true,
+			want:       BillableWeight{Unit: WeightUnitPounds, Divisor: DimDivisorDailyIN, ActualWeight: 10, BillableWeight: 10},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if tt.simpleRate {
+				tt.pkg.SimpleRate = &SimpleRate{Code: SimpleRateExtraLarge}
+			}
+
+			got, err := CalculateBillableWeight(&tt.pkg, tt.options)
+			if err != nil {
+				t.Fatalf("CalculateBillableWeight() error = %v", err)
+			}
+
+			if *got != tt.want {
+				t.Errorf("CalculateBillableWeight() = %+v, want %+v", *got, tt.want)
+			}
+		})
+	}
+}
+
+func TestCalculateBillableWeightErrors(t *testing.T) {
+	tests := []struct {
+		name string
+		pkg  Package
+	}{
+		{"no weight or dimensions", Package{}},
+		{"invalid weight", Package{PackageWeight: &PackageWeight{UnitOfMeasurement: PackageWeightUnitOfMeasurement{Code: string(WeightUnitPounds)}, Weight: "ten"}}},
+		{"unknown unit", Package{Dimensions: Dimensions{UnitOfMeasurement: DimensionsUnitOfMeasurement{Code: "MM"}, Length: "1", Width: "1", Height: "1"}}},
+		{"missing side", Package{Dimensions: Dimensions{UnitOfMeasurement: DimensionsUnitOfMeasurement{Code: string(DimensionUnitInches)}, Length: "1", Width: "1"}}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := CalculateBillableWeight(&tt.pkg, BillableWeightOptions{}); err == nil {
+				t.Error("CalculateBillableWeight() error = nil")
+			}
+		})
+	}
+}
+
+func TestSetSizeIndicators(t *testing.T) {
+	s := testShipment("US", "US")
+	s.Packages = []Package{
+		{Dimensions: Dimensions{UnitOfMeasurement: DimensionsUnitOfMeasurement{Code: string(DimensionUnitInches)}, Length: "97", Width: "10", Height: "5"}},
+		{Dimensions: Dimensions{UnitOfMeasurement: DimensionsUnitOfMeasurement{Code: string(DimensionUnitInches)}, Length: "49", Width: "10", Height: "5"}},
+		{PackageWeight: &PackageWeight{UnitOfMeasurement: PackageWeightUnitOfMeasurement{Code: string(WeightUnitPounds)}, Weight: "5"}, AdditionalHandlingIndicator: " "},
+	}
+
+	if err := s.SetSizeIndicators(); err != nil {
+		t.Fatalf("SetSizeIndicators() error = %v", err)
+	}
+
+	want := [][2]string{{" ", ""}, {"", " "}, {"", " "}}
+	for i, p := range s.Packages {
+		if got := [2]string{p.LargePackageIndicator, p.AdditionalHandlingIndicator}; got != want[i] {
+			t.Errorf("package %d indicators = %q, want %q", i, got, want[i])
+		}
+	}
+}
@@ -0,0 +1,160 @@
+package ups
+
+import (
+	"fmt"
+	"slices"
+)
+
+// codCountries contains the destination countries or territories where UPS
+// collects C.O.D. payments.
+var codCountries = []string{
+	"AT", "BE", "BG", "CA", "CH", "CY", "CZ", "DE", "DK", "EE", "ES", "FI", "FR", "GB",
+	"GR", "HR", "HU", "IE", "IT", "LI", "LT", "LU", "LV", "MC", "MT", "MX", "NL", "NO",
+	"PL", "PR", "PT", "RO", "SE", "SI", "SK", "SM", "US",
+}
+
+// codUnsupportedServices contains the services which can't be combined with
+// C.O.D.: Mail Innovations, Worldwide Economy and Worldwide Express Freight.
+var codUnsupportedServices = []ServiceCode{
+	ServiceWorldwideEconomyDDU, ServiceWorldwideExpressFreightMidday, ServiceWorldwideEconomy, ServiceWorldwideExpressFreight,
+	ServiceFirstClassMail, ServicePriorityMail, ServiceExpeditedMailInnovations, ServicePriorityMailInnovations,
+	ServiceEconomyMailInnovations, ServiceMailInnovationsReturns,
+}
+
+// isPackageLevelCOD reports whether C.O.D. has to be requested per package
+// instead of per shipment for the origin and destination.
+func isPackageLevelCOD(origin, destination string) bool {
+	switch origin {
+	case "US", "PR":
+		return destination == "US" || destination == "PR"
+	case "CA":
+		return destination == "CA" || destination == "US"
+	}
+
+	return false
+}
+
+// IsCODAllowed reports whether C.O.D. is available for the service, origin
+// and destination of the shipment.
+func (s *Shipment) IsCODAllowed() bool {
+	return len(s.validateCODAvailability("Shipment")) == 0
+}
+
+func (s *Shipment) validateCODAvailability(field string) []error {
+	var errs []error
+
+	if slices.Contains(codUnsupportedServices, ServiceCode(s.Service.Code)) {
+		errs = append(errs, newValidationError(field, "COD is not available for service %s", s.Service.Code))
+	}
+
+	if s.IsReturn() {
+		errs = append(errs, newValidationError(field, "COD is not available for return shipments"))
+	}
+
+	if destination := s.destinationCountryCode(); !slices.Contains(codCountries, destination) {
+		errs = append(errs, newValidationError(field, "COD is not available for destination %s", destination))
+	}
+
+	return errs
+}
+
+func (s *Shipment) validateCOD() []error {
+	var errs []error
+
+	origin, destination := s.originCountryCode(), s.destinationCountryCode()
+	packageLevel := isPackageLevelCOD(origin, destination)
+
+	if options := s.ShipmentServiceOptions; options != nil {
+		if options.COD != nil {
+			field := "Shipment.ShipmentServiceOptions.COD"
+
+			errs = append(errs, s.validateCODAvailability(field)...)
+			if packageLevel {
+				errs = append(errs, newValidationError(field, "COD from %s to %s has to be requested per package", origin, destination))
+			}
+			if options.AccessPointCOD != nil {
+				errs = append(errs, newValidationError(field, "not valid with AccessPointCOD"))
+			}
+
+			errs = append(errs, options.COD.validate(field)...)
+		}
+
+		if options.AccessPointCOD != nil {
+			field := "Shipment.ShipmentServiceOptions.AccessPointCOD"
+
+			if !isEUCountry(origin) || !isEUCountry(destination) {
+				errs = append(errs, newValidationError(field, "only valid within the E.U."))
+			}
+
+			errs = append(errs, options.AccessPointCOD.validate(field)...)
+		}
+	}
+
+	for i, p := range s.Packages {
+		if p.PackageServiceOptions == nil {
+			continue
+		}
+
+		if p.PackageServiceOptions.COD != nil {
+			field := fmt.Sprintf("Shipment.Package[%d].PackageServiceOptions.COD", i)
+
+			errs = append(errs, s.validateCODAvailability(field)...)
+			if !packageLevel {
+				errs = append(errs, newValidationError(field, "COD from %s to %s has to be requested per shipment", origin, destination))
+			}
+			if p.PackageServiceOptions.AccessPointCOD != nil {
+				errs = append(errs, newValidationError(field, "not valid with AccessPointCOD"))
+			}
+			if code := p.PackageServiceOptions.COD.CODFundsCode; code != "" && code != "0" && code != "8" {
+				errs = append(errs, newValidationError(field+".CODFundsCode", "%q is not valid for package level COD", code))
+			}
+
+			errs = append(errs, p.PackageServiceOptions.COD.validate(field)...)
+		}
+
+		if p.PackageServiceOptions.AccessPointCOD != nil {
+			field := fmt.Sprintf("Shipment.Package[%d].PackageServiceOptions.AccessPointCOD", i)
+
+			usPR := (origin == "US" || origin == "PR") && (destination == "US" || destination == "PR")
+			if !usPR && (origin != "CA" || destination != "CA") {
+				errs = append(errs, newValidationError(field, "only valid from US/PR to US/PR and CA to CA"))
+			}
+
+			errs = append(errs, p.PackageServiceOptions.AccessPointCOD.validate(field)...)
+		}
+	}
+
+	return errs
+}
+
+func (c *COD) validate(field string) []error {
+	var errs []error
+
+	if c.CODFundsCode == "" {
+		errs = append(errs, newValidationError(field+".CODFundsCode", "is required"))
+	}
+
+	if err := validateCurrencyCode(field+".CODAmount.CurrencyCode", c.CODAmount.CurrencyCode); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := validateMonetaryValue(field+".CODAmount.MonetaryValue", c.CODAmount.MonetaryValue); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
+
+func (c *AccessPointCOD) validate(field string) []error {
+	var errs []error
+
+	if err := validateCurrencyCode(field+".CurrencyCode", c.CurrencyCode); err != nil {
+		errs = append(errs, err)
+	}
+
+	if err := validateMonetaryValue(field+".MonetaryValue", c.MonetaryValue); err != nil {
+		errs = append(errs, err)
+	}
+
+	return errs
+}
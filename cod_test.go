@@ -0,0 +1,213 @@
+package ups
+
+import (
+	"errors"
+	"strings"
+	"testing"
+)
+
+// testShipment returns a shipment from origin to destination.
+func testShipment(origin, destination string) Shipment {
+	return Shipment{
+		Shipper: Shipper{Address: ShipperAddress{CountryCode: origin}},
+		ShipTo:  ShipTo{Address: ShipToAddress{CountryCode: destination}},
+	}
+}
+
+// validationFields returns the fields of the validation errors joined in err.
+func validationFields(err error) []string {
+	if err == nil {
+		return nil
+	}
+
+	var fields []string
+
+	joined, ok := err.(interface{ Unwrap() []error })
+	if !ok {
+		return []string{err.Error()}
+	}
+
+	for _, err := range joined.Unwrap() {
+		var validationErr *ValidationError
+		if errors.As(err, &validationErr) {
+			fields = append(fields, validationErr.Field)
+		} else {
+			fields = append(fields, err.Error())
+		}
+	}
+
+	return fields
+}
+
+func checkValidationFields(t *testing.T, err error, want []string) {
+	t.Helper()
+
+	if got := validationFields(err); strings.Join(got, "\n") != strings.Join(want, "\n") {
+		t.Errorf("Validate() error fields = %q, want %q\nerror: %v", got, want, err)
+	}
+}
+
+func TestValidateCOD(t *testing.T) {
+	amount := CODAmount{CurrencyCode: "EUR", MonetaryValue: "100"}
+	accessPointCOD := &AccessPointCOD{CurrencyCode: "EUR", MonetaryValue: "100"}
+
+	tests := []struct {
+		name                  string
+		origin, destination   string
+		service               ServiceCode
+		returns               bool
+		shipmentCOD           *COD
+		shipmentAccessPoint   *AccessPointCOD
+		packageCOD            *COD
+		packageAccessPointCOD *AccessPointCOD
+		want                  []string
+	}{
+		{
+			name:        "shipment level",
+			origin:      "DE",
+			destination: "FR",
+			shipmentCOD: &COD{CODFundsCode: "1", CODAmount: amount},
+		},
+		{
+			name:        "shipment level within the US",
+			origin:      "US",
+			destination: "us",
+			shipmentCOD: &COD{CODFundsCode: "1", CODAmount: amount},
+			want:        []string{"Shipment.ShipmentServiceOptions.COD"},
+		},
+		{
+			name:        "package level within the US",
+			origin:      "US",
+			destination: "PR",
+			packageCOD:  &COD{CODFundsCode: "0", CODAmount: amount},
+		},
+		{
+			name:        "package level from CA to US",
+			origin:      "CA",
+			destination: "US",
+			packageCOD:  &COD{CODFundsCode: "8", CODAmount: amount},
+		},
+		{
+			name:        "package level for other countries",
+			origin:      "DE",
+			destination: "FR",
+			packageCOD:  &COD{CODFundsCode: "0", CODAmount: amount},
+			want:        []string{"Shipment.Package[0].PackageServiceOptions.COD"},
+		},
+		{
+			name:        "package level funds code",
+			origin:      "US",
+			destination: "US",
+			packageCOD:  &COD{CODFundsCode: "1", CODAmount: amount},
+			want:        []string{"Shipment.Package[0].PackageServiceOptions.COD.CODFundsCode"},
+		},
+		{
+			name:        "unsupported service",
+			origin:      "DE",
+			destination: "FR",
+			service:     ServiceWorldwideEconomyDDU,
+			shipmentCOD: &COD{CODFundsCode: "1", CODAmount: amount},
+			want:        []string{"Shipment.ShipmentServiceOptions.COD"},
+		},
+		{
+			name:        "return shipment",
+			origin:      "DE",
+			destination: "FR",
+			returns:     true,
+			shipmentCOD: &COD{CODFundsCode: "1", CODAmount: amount},
+			want:        []string{"Shipment.ShipmentServiceOptions.COD"},
+		},
+		{
+			name:        "unsupported destination",
+			origin:      "DE",
+			destination: "JP",
+			shipmentCOD: &COD{CODFundsCode: "1", CODAmount: amount},
+			want:        []string{"Shipment.ShipmentServiceOptions.COD"},
+		},
+		{
+			name:        "invalid amount",
+			origin:      "DE",
+			destination: "FR",
+			shipmentCOD: &COD{CODAmount: CODAmount{CurrencyCode: "EU", MonetaryValue: "1.234"}},
+			want: []string{
+				"Shipment.ShipmentServiceOptions.COD.CODFundsCode",
+				"Shipment.ShipmentServiceOptions.COD.CODAmount.CurrencyCode",
+				"Shipment.ShipmentServiceOptions.COD.CODAmount.MonetaryValue",
+			},
+		},
+		{
+			name:                "access point",
+			origin:              "DE",
+			destination:         "FR",
+			shipmentAccessPoint: accessPointCOD,
+		},
+		{
+			name:                "access point outside the E.U.",
+			origin:              "DE",
+			destination:         "CH",
+			shipmentAccessPoint: accessPointCOD,
+			want:                []string{"Shipment.ShipmentServiceOptions.AccessPointCOD"},
+		},
+		{
+			name:                "COD and access point COD",
+			origin:              "DE",
+			destination:         "FR",
+			shipmentCOD:         &COD{CODFundsCode: "1", CODAmount: amount},
+			shipmentAccessPoint: accessPointCOD,
+			want:                []string{"Shipment.ShipmentServiceOptions.COD"},
+		},
+		{
+			name:                  "package level access point",
+			origin:                "CA",
+			destination:           "CA",
+			packageAccessPointCOD: &AccessPointCOD{CurrencyCode: "CAD", MonetaryValue: "100"},
+		},
+		{
+			name:                  "package level access point in other countries",
+			origin:                "DE",
+			destination:           "DE",
+			packageAccessPointCOD: &AccessPointCOD{CurrencyCode: "EUR", MonetaryValue: "0"},
+			want: []string{
+				"Shipment.Package[0].PackageServiceOptions.AccessPointCOD",
+				"Shipment.Package[0].PackageServiceOptions.AccessPointCOD.MonetaryValue",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := testShipment(tt.origin, tt.destination)
+			s.Service.Code = string(tt.service)
+			s.ShipmentServiceOptions = &ShipmentServiceOptions{COD: tt.shipmentCOD, AccessPointCOD: tt.shipmentAccessPoint}
+			s.Packages = []Package{{PackageServiceOptions: &PackageServiceOptions{COD: tt.packageCOD, AccessPointCOD: tt.packageAccessPointCOD}}}
+
+			if tt.returns {
+				s.ReturnService = &ReturnService{Code: ReturnServiceThreeAttempt}
+			}
+
+			checkValidationFields(t, errors.Join(s.validateCOD()...), tt.want)
+		})
+	}
+}
+
+func TestIsCODAllowed(t *testing.T) {
+	tests := []struct {
+		origin, destination string
+		service             ServiceCode
+		want                bool
+	}{
+		{"DE", "FR", ServiceStandard, true},
+		{"US", "CA", ServiceGround, true},
+		{"DE", "JP", ServiceWorldwideExpress, false},
+		{"US", "US", ServicePriorityMail, false},
+	}
+
+	for _, tt := range tests {
+		s := testShipment(tt.origin, tt.destination)
+		s.Service.Code = string(tt.service)
+
+		if got := s.IsCODAllowed(); got != tt.want {
+			t.Errorf("IsCODAllowed() from %s to %s with %s = %t, want %t", tt.origin, tt.destination, tt.service, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,153 @@
+package ups
+
+import (
+	"errors"
+	"fmt"
+	"math/big"
+	"slices"
+	"strings"
+)
+
+// freightClasses contains the valid values of Commodity.FreightClass.
+var freightClasses = []string{
+	"50", "55", "60", "65", "70", "77.5", "85", "92.5", "100", "110", "125", "150", "175",
+	"200", "250", "300", "400", "500",
+}
+
+// IsValidFreightClass reports whether class is one of the NMFC freight
+// classes.
+func IsValidFreightClass(class string) bool {
+	return slices.Contains(freightClasses, class)
+}
+
+// Total returns the value of the product line, the number of units times the
+// unit value.
+func (p *Product) Total() (*big.Rat, error) {
+	if p.Unit == nil {
+		return nil, errors.New("product has no unit")
+	}
+
+	number, ok := new(big.Rat).SetString(strings.TrimSpace(p.Unit.Number))
+	if !ok {
+		return nil, fmt.Errorf("invalid unit number %q", p.Unit.Number)
+	}
+
+	value, ok := new(big.Rat).SetString(strings.TrimSpace(p.Unit.Value))
+	if !ok {
+		return nil, fmt.Errorf("invalid unit value %q", p.Unit.Value)
+	}
+
+	return number.Mul(number, value), nil
+}
+
+// InvoiceLineTotal returns the sum of all product lines in the currency of
+// the forms, rounded to two decimal places.
+func (f *InternationalForms) InvoiceLineTotal() (*InvoiceLineTotal, error) {
+	if len(f.Products) == 0 {
+		return nil, errors.New("international forms contain no products")
+	}
+
+	total := new(big.Rat)
+	for i := range f.Products {
+		line, err := f.Products[i].Total()
+		if err != nil {
+			return nil, fmt.Errorf("product %d: %w", i, err)
+		}
+
+		total.Add(total, line)
+	}
+
+	return &InvoiceLineTotal{
+		CurrencyCode:  f.CurrencyCode,
+		MonetaryValue: total.FloatString(2),
+	}, nil
+}
+
+// RequiresInvoiceLineTotal reports whether UPS requires InvoiceLineTotal,
+// which is the case for forward shipments from the US to Puerto Rico or
+// Canada.
+func (s *Shipment) RequiresInvoiceLineTotal() bool {
+	destination := s.destinationCountryCode()
+
+	return !s.IsReturn() && s.originCountryCode() == "US" && (destination == "PR" || destination == "CA")
+}
+
+// SetInvoiceLineTotal sets InvoiceLineTotal to the sum of the products of
+// ShipmentServiceOptions.InternationalForms.
+func (s *Shipment) SetInvoiceLineTotal() error {
+	if s.ShipmentServiceOptions == nil || s.ShipmentServiceOptions.InternationalForms == nil {
+		return errors.New("shipment has no international forms")
+	}
+
+	total, err := s.ShipmentServiceOptions.InternationalForms.InvoiceLineTotal()
+	if err != nil {
+		return err
+	}
+
+	s.InvoiceLineTotal = total
+
+	return nil
+}
+
+// equalMonetaryValues reports whether a and b are the same amount, e.g. 100
+// and 100.00. Values which are not numbers are never equal.
+func equalMonetaryValues(a, b string) bool {
+	x, ok := new(big.Rat).SetString(strings.TrimSpace(a))
+	if !ok {
+		return false
+	}
+
+	y, ok := new(big.Rat).SetString(strings.TrimSpace(b))
+	if !ok {
+		return false
+	}
+
+	return x.Cmp(y) == 0
+}
+
+func (s *Shipment) validateCustoms() []error {
+	var errs []error
+
+	if s.InvoiceLineTotal != nil {
+		if !s.RequiresInvoiceLineTotal() {
+			errs = append(errs, newValidationError("Shipment.InvoiceLineTotal", "only available for forward shipments from US to PR or CA"))
+		}
+
+		if err := validateMonetaryValue("Shipment.InvoiceLineTotal.MonetaryValue", s.InvoiceLineTotal.MonetaryValue); err != nil {
+			errs = append(errs, err)
+		} else if s.ShipmentServiceOptions != nil && s.ShipmentServiceOptions.InternationalForms != nil {
+			forms := s.ShipmentServiceOptions.InternationalForms
+
+			if expected, err := forms.InvoiceLineTotal(); err == nil {
+				if !equalMonetaryValues(expected.MonetaryValue, s.InvoiceLineTotal.MonetaryValue) || !strings.EqualFold(expected.CurrencyCode, s.InvoiceLineTotal.CurrencyCode) {
+					errs = append(errs, newValidationError("Shipment.InvoiceLineTotal", "%s %s does not match the products of the international forms, %s %s",
+						s.InvoiceLineTotal.MonetaryValue, s.InvoiceLineTotal.CurrencyCode, expected.MonetaryValue, expected.CurrencyCode))
+				}
+			}
+		}
+	} else if s.RequiresInvoiceLineTotal() {
+		errs = append(errs, newValidationError("Shipment.InvoiceLineTotal", "is required for shipments from US to PR or CA"))
+	}
+
+	for i, p := range s.Packages {
+		if p.Commodity == nil {
+			continue
+		}
+
+		field := fmt.Sprintf("Shipment.Package[%d].Commodity", i)
+
+		if !s.IsGroundFreightPricing() {
+			errs = append(errs, newValidationError(field, "only valid for Ground Freight Pricing shipments"))
+		}
+
+		if !IsValidFreightClass(p.Commodity.FreightClass) {
+			errs = append(errs, newValidationError(field+".FreightClass", "%q is not a valid freight class", p.Commodity.FreightClass))
+		}
+
+		if nmfc := p.Commodity.NMFC; nmfc != nil && (len(nmfc.PrimeCode) < 4 || len(nmfc.PrimeCode) > 6) {
+			errs = append(errs, newValidationError(field+".NMFC.PrimeCode", "%q must have 4 to 6 characters", nmfc.PrimeCode))
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,177 @@
+package ups
+
+import (
+	"errors"
+	"testing"
+)
+
+func testProduct(number, value string) Product {
+	return Product{
+		Descriptions: []string{"Widget"},
+		Unit: &ProductUnit{
+			Number:            number,
+			UnitOfMeasurement: ProductUnitOfMeasurement{Code: "PCS"},
+			Value:             value,
+		},
+	}
+}
+
+func TestInvoiceLineTotal(t *testing.T) {
+	tests := []struct {
+		name     string
+		products []Product
+		want     string
+		wantErr  bool
+	}{
+		{"single product", []Product{testProduct("2", "10.5")}, "21.00", false},
+		{"several products", []Product{testProduct("3", "0.1"), testProduct("1", "0.2")}, "0.50", false},
+		{"six decimal places", []Product{testProduct("3", "0.333333")}, "1.00", false},
+		{"rounded half up", []Product{testProduct("1", "0.125")}, "0.13", false},
+		{"no products", nil, "", true},
+		{"no unit", []Product{{Descriptions: []string{"Widget"}}}, "", true},
+		{"invalid value", []Product{testProduct("1", "1,5")}, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			forms := InternationalForms{CurrencyCode: "USD", Products: tt.products}
+
+			got, err := forms.InvoiceLineTotal()
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("InvoiceLineTotal() error = %v, want error %t", err, tt.wantErr)
+			}
+
+			if err == nil && (got.MonetaryValue != tt.want || got.CurrencyCode != "USD") {
+				t.Errorf("InvoiceLineTotal() = %s %s, want %s USD", got.MonetaryValue, got.CurrencyCode, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateCustoms(t *testing.T) {
+	forms := &InternationalForms{
+		FormTypes:    []string{"01"},
+		CurrencyCode: "USD",
+		Products:     []Product{testProduct("2", "50")},
+	}
+
+	tests := []struct {
+		name        string
+		destination string
+		total       *InvoiceLineTotal
+		returns     bool
+		commodity   *Commodity
+		freight     bool
+		want        []string
+	}{
+		{
+			name:        "matching total",
+			destination: "CA",
+			total:       &InvoiceLineTotal{CurrencyCode: "USD", MonetaryValue: "100.00"},
+		},
+		{
+			name:        "matching total without decimal places",
+			destination: "CA",
+			total:       &InvoiceLineTotal{CurrencyCode: "usd", MonetaryValue: "100"},
+		},
+		{
+			name:        "matching total with one decimal place",
+			destination: "PR",
+			total:       &InvoiceLineTotal{CurrencyCode: "USD", MonetaryValue: "100.0"},
+		},
+		{
+			name:        "different total",
+			destination: "CA",
+			total:       &InvoiceLineTotal{CurrencyCode: "USD", MonetaryValue: "100.01"},
+			want:        []string{"Shipment.InvoiceLineTotal"},
+		},
+		{
+			name:        "different currency",
+			destination: "CA",
+			total:       &InvoiceLineTotal{CurrencyCode: "CAD", MonetaryValue: "100"},
+			want:        []string{"Shipment.InvoiceLineTotal"},
+		},
+		{
+			name:        "invalid total",
+			destination: "CA",
+			total:       &InvoiceLineTotal{CurrencyCode: "USD", MonetaryValue: "100.001"},
+			want:        []string{"Shipment.InvoiceLineTotal.MonetaryValue"},
+		},
+		{
+			name:        "missing total",
+			destination: "CA",
+			want:        []string{"Shipment.InvoiceLineTotal"},
+		},
+		{
+			name:        "total for other destination",
+			destination: "MX",
+			total:       &InvoiceLineTotal{CurrencyCode: "USD", MonetaryValue: "100"},
+			want:        []string{"Shipment.InvoiceLineTotal"},
+		},
+		{
+			name:        "no total for return shipment",
+			destination: "CA",
+			returns:     true,
+		},
+		{
+			name:        "commodity for Ground Freight Pricing",
+			destination: "MX",
+			commodity:   &Commodity{FreightClass: "77.5", NMFC: &NMFC{PrimeCode: "116030"}},
+			freight:     true,
+		},
+		{
+			name:        "invalid commodity",
+			destination: "MX",
+			commodity:   &Commodity{FreightClass: "80", NMFC: &NMFC{PrimeCode: "116"}},
+			want: []string{
+				"Shipment.Package[0].Commodity",
+				"Shipment.Package[0].Commodity.FreightClass",
+				"Shipment.Package[0].Commodity.NMFC.PrimeCode",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := testShipment("US", tt.destination)
+			s.InvoiceLineTotal = tt.total
+			s.ShipmentServiceOptions = &ShipmentServiceOptions{InternationalForms: forms}
+			s.Packages = []Package{{Commodity: tt.commodity}}
+
+			if tt.returns {
+				s.ReturnService = &ReturnService{Code: ReturnServiceThreeAttempt}
+			}
+
+			if tt.freight {
+				s.FRSPaymentInformation = &FRSPaymentInformation{}
+			}
+
+			checkValidationFields(t, errors.Join(s.validateCustoms()...), tt.want)
+		})
+	}
+}
+
+func TestSetInvoiceLineTotal(t *testing.T) {
+	s := testShipment("US", "CA")
+
+	if err := s.SetInvoiceLineTotal(); err == nil {
+		t.Error("SetInvoiceLineTotal() without international forms error = nil")
+	}
+
+	s.ShipmentServiceOptions = &ShipmentServiceOptions{InternationalForms: &InternationalForms{
+		CurrencyCode: "USD",
+		Products:     []Product{testProduct("3", "19.99"), testProduct("1", "0.03")},
+	}}
+
+	if err := s.SetInvoiceLineTotal(); err != nil {
+		t.Fatalf("SetInvoiceLineTotal() error = %v", err)
+	}
+
+	if got := s.InvoiceLineTotal; got == nil || got.MonetaryValue != "60.00" || got.CurrencyCode != "USD" {
+		t.Errorf("InvoiceLineTotal = %+v, want 60.00 USD", got)
+	}
+
+	if errs := s.validateCustoms(); len(errs) != 0 {
+		t.Errorf("validateCustoms() = %v, want no errors", errs)
+	}
+}
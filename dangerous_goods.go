@@ -0,0 +1,449 @@
+package ups
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+)
+
+type ChemicalReferenceDataRequest struct {
+	// Request container.
+	Request *DangerousGoodsRequest `json:",omitempty"`
+	// This is the ID number (UN/NA/ID) for the specified commodity.
+	// Either IDNumber or ProperShippingName has to be provided.
+	IDNumber string `json:",omitempty" validate:"max=6"`
+	// The Proper Shipping Name assigned by ADR, CFR or IATA.
+	// Either IDNumber or ProperShippingName has to be provided.
+	ProperShippingName string `json:",omitempty" validate:"max=250"`
+	// Shipper's six digit account number.
+	ShipperNumber string `json:",omitempty" validate:"max=6"`
+}
+
+type DangerousGoodsRequest struct {
+	// Enables the user to specify optional processing.
+	RequestOption string `json:",omitempty"`
+	// TransactionReference identifies transactions between client and
+	// server.
+	TransactionReference *TransactionReference `json:",omitempty"`
+}
+
+type ChemicalReferenceDataResponse struct {
+	// Response container.
+	Response Response
+	// Container to hold chemical data. One container is returned per
+	// matching regulation set and chemical record.
+	ChemicalData []ChemicalData
+}
+
+func (r *ChemicalReferenceDataResponse) UnmarshalJSON(data []byte) error {
+	type alias ChemicalReferenceDataResponse
+
+	var v struct {
+		alias
+		ChemicalData json.RawMessage
+	}
+
+	err := json.Unmarshal(data, &v)
+	if err != nil {
+		return err
+	}
+
+	*r = ChemicalReferenceDataResponse(v.alias)
+
+	if len(v.ChemicalData) > 0 {
+		return unmarshalOneOrMany(v.ChemicalData, &r.ChemicalData)
+	}
+
+	return nil
+}
+
+type ChemicalData struct {
+	// Container to hold Chemical Detail information.
+	ChemicalDetail ChemicalDetail
+	// Container to hold Proper Shipping Name Detail information.
+	ProperShippingNameDetail ProperShippingNameDetail
+	// Container to hold Package Quantity Limit Detail information.
+	PackageQuantityLimitDetail []PackageQuantityLimitDetail
+}
+
+func (c *ChemicalData) UnmarshalJSON(data []byte) error {
+	type alias ChemicalData
+
+	var v struct {
+		alias
+		PackageQuantityLimitDetail json.RawMessage
+	}
+
+	err := json.Unmarshal(data, &v)
+	if err != nil {
+		return err
+	}
+
+	*c = ChemicalData(v.alias)
+
+	if len(v.PackageQuantityLimitDetail) > 0 {
+		return unmarshalOneOrMany(v.PackageQuantityLimitDetail, &c.PackageQuantityLimitDetail)
+	}
+
+	return nil
+}
+
+type ChemicalDetail struct {
+	// The Regulatory set associated with the chemical record. Valid
+	// values: ADR, CFR, IATA, TDG.
+	RegulationSet string
+	// The ID number (UN/NA/ID) for the specified commodity.
+	IDNumber string
+	// Description of the hazardous material.
+	HazardousMaterialsDescription string
+	// The hazard class associated to the specified commodity.
+	ClassDivisionNumber string
+	// Secondary hazardous characteristics of the commodity.
+	SubRiskClass string
+	// Indicates whether a technical name is required.
+	TechnicalNameRequiredIndicator string
+	// The packing group category associated to the specified commodity.
+	// Valid values: I, II, III, blank.
+	PackagingGroupType string
+	// Additional shipping information about the commodity.
+	AdditionalShippingInformation string
+	// Defines the type of label that is required on the package.
+	HazardLabelRequired string
+	// Indicates the type of commodity. Valid values: LR, FR, LQ, EQ.
+	CommodityRegulatedLevelCode string
+	// Transport category. Valid values: 0 to 4.
+	TransportCategory string
+	// Defines what is restricted to pass through a tunnel.
+	TunnelRestrictionCode string
+}
+
+type ProperShippingNameDetail struct {
+	// The Proper Shipping Name assigned by ADR, CFR or IATA.
+	ProperShippingName string
+}
+
+type PackageQuantityLimitDetail struct {
+	// Type of the quantity limit. Valid values: CAO, PAX, GND.
+	PackageQuantityLimitTypeCode string
+	// Maximum quantity per package.
+	Quantity string
+	// The unit of measure of the quantity.
+	UOM string
+	// The packing instructions for the limit.
+	PackagingInstructionCode string
+	// Special provisions of the commodity.
+	SpecialProvision string
+}
+
+// ChemicalReferenceData searches the UPS dangerous goods chemical reference
+// data by UN number and/or proper shipping name.
+func (c *Client) ChemicalReferenceData(ctx context.Context, request ChemicalReferenceDataRequest) (*ChemicalReferenceDataResponse, error) {
+	if request.IDNumber == "" && request.ProperShippingName == "" {
+		return nil, errors.New("either IDNumber or ProperShippingName is required")
+	}
+
+	var response struct {
+		ChemicalReferenceDataResponse *ChemicalReferenceDataResponse
+	}
+
+	err := c.do(ctx, http.MethodPost, dangerousGoodsURL+"/chemicalreferencedata", struct {
+		ChemicalReferenceDataRequest ChemicalReferenceDataRequest
+	}{
+		ChemicalReferenceDataRequest: request,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.ChemicalReferenceDataResponse, nil
+}
+
+type AcceptanceAuditPreCheckRequest struct {
+	// Request container.
+	Request *DangerousGoodsRequest `json:",omitempty"`
+	// The time the shipment record was created. Format:
+	// YYYY-MM-DDTHH:MM:SS.
+	OriginRecordTransactionTimestamp string
+	// Shipment container.
+	Shipment AcceptanceAuditShipment
+}
+
+type AcceptanceAuditShipment struct {
+	// Shipper's six digit account number.
+	ShipperNumber string `validate:"len=6"`
+	// Address the shipment is shipped from.
+	ShipFromAddress DangerousGoodsAddress
+	// Address the shipment is shipped to.
+	ShipToAddress DangerousGoodsAddress
+	// UPS service type.
+	Service Service
+	// The Regulatory set associated with every regulated shipment. It
+	// must be the same across the shipment. Valid values: ADR, CFR, IATA,
+	// TDG.
+	RegulationSet string `validate:"min=3,max=4"`
+	// Package containers. Up to 200 packages are allowed.
+	Packages []AcceptanceAuditPackage `json:"Package" validate:"required,max=200,dive"`
+}
+
+type DangerousGoodsAddress struct {
+	AddressLines      []string `json:"AddressLine,omitempty" validate:"max=3,dive,max=35"`
+	City              string   `json:",omitempty" validate:"max=30"`
+	StateProvinceCode string   `json:",omitempty" validate:"max=5"`
+	PostalCode        string   `json:",omitempty" validate:"max=9"`
+	CountryCode       string   `validate:"len=2"`
+}
+
+type AcceptanceAuditPackage struct {
+	// Identifies the package containing Dangerous Goods.
+	PackageIdentifier string `validate:"max=5"`
+	// Container to hold package weight information.
+	PackageWeight *PackageWeight `json:",omitempty"`
+	// Q-Value of all packed in one IATA packages.
+	QValue string `json:",omitempty" validate:"max=3"`
+	// Presence/Absence indicator. Indicates that the package is over
+	// packed.
+	OverPackedIndicator string `json:",omitempty"`
+	// Presence/Absence indicator. Indicates the package is all packed in
+	// one.
+	AllPackedInOneIndicator string `json:",omitempty"`
+	// Transportation mode of the package. Valid values: GND, CAO, PAX.
+	TransportationMode string `validate:"min=1,max=30"`
+	// 24 Hour Emergency Phone Number of the shipper.
+	EmergencyPhone string `json:",omitempty" validate:"max=25"`
+	// The emergency information, contact name and/or contract number.
+	EmergencyContact string `json:",omitempty" validate:"max=35"`
+	// Chemical records of the package. Up to three are allowed.
+	ChemicalRecords []HazMat `json:"ChemicalRecord" validate:"required,max=3,dive"`
+}
+
+type AcceptanceAuditPreCheckResponse struct {
+	// Response container.
+	Response Response
+	// Results per package.
+	PackageResults []AcceptanceAuditPackageResults
+}
+
+func (r *AcceptanceAuditPreCheckResponse) UnmarshalJSON(data []byte) error {
+	type alias AcceptanceAuditPreCheckResponse
+
+	var v struct {
+		alias
+		PackageResults json.RawMessage
+	}
+
+	err := json.Unmarshal(data, &v)
+	if err != nil {
+		return err
+	}
+
+	*r = AcceptanceAuditPreCheckResponse(v.alias)
+
+	if len(v.PackageResults) > 0 {
+		return unmarshalOneOrMany(v.PackageResults, &r.PackageResults)
+	}
+
+	return nil
+}
+
+type AcceptanceAuditPackageResults struct {
+	// Identifies the package containing Dangerous Goods.
+	PackageIdentifier string
+	// Indicates whether the package is accessible during transport.
+	AccessibleIndicator string
+	// Indicates whether the package requires a cargo aircraft.
+	AircraftIndicator string
+	// Results per chemical record.
+	ChemicalRecordResults []ChemicalRecordResults
+}
+
+func (r *AcceptanceAuditPackageResults) UnmarshalJSON(data []byte) error {
+	type alias AcceptanceAuditPackageResults
+
+	var v struct {
+		alias
+		ChemicalRecordResults json.RawMessage
+	}
+
+	err := json.Unmarshal(data, &v)
+	if err != nil {
+		return err
+	}
+
+	*r = AcceptanceAuditPackageResults(v.alias)
+
+	if len(v.ChemicalRecordResults) > 0 {
+		return unmarshalOneOrMany(v.ChemicalRecordResults, &r.ChemicalRecordResults)
+	}
+
+	return nil
+}
+
+type ChemicalRecordResults struct {
+	// Identifies the Chemical Record.
+	ChemicalRecordIdentifier string
+	// Reportable quantity of the chemical record.
+	ReportableQuantity string
+	// The hazard class associated to the specified commodity.
+	ClassDivisionNumber string
+	// The ID number (UN/NA/ID) for the specified commodity.
+	IDNumber string
+	// The packing group category associated to the specified commodity.
+	PackagingGroupType string
+	// Description of the hazardous material.
+	HazardousMaterialsDescription string
+	// Indicates the type of commodity. Valid values: LR, FR, LQ, EQ.
+	CommodityRegulatedLevelCode string
+}
+
+// AcceptanceAuditPreCheck verifies that UPS will accept the dangerous goods
+// of the shipment.
+func (c *Client) AcceptanceAuditPreCheck(ctx context.Context, request AcceptanceAuditPreCheckRequest) (*AcceptanceAuditPreCheckResponse, error) {
+	var response struct {
+		AcceptanceAuditPreCheckResponse *AcceptanceAuditPreCheckResponse
+	}
+
+	err := c.do(ctx, http.MethodPost, dangerousGoodsURL+"/acceptanceauditprecheck", struct {
+		AcceptanceAuditPreCheckRequest AcceptanceAuditPreCheckRequest
+	}{
+		AcceptanceAuditPreCheckRequest: request,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.AcceptanceAuditPreCheckResponse, nil
+}
+
+// PackageAcceptance is the result of the acceptance audit pre-check of a
+// single package.
+type PackageAcceptance struct {
+	// Index of the package in Shipment.Packages.
+	Index int
+	// PackageIdentifier sent to UPS.
+	PackageIdentifier string
+	// Accepted is true if UPS accepted the dangerous goods of the package.
+	Accepted bool
+	// Errors returned by UPS if the package was not accepted.
+	Errors []Error
+	// Alerts returned by UPS for an accepted package.
+	Alerts []Alert
+	// Result returned by UPS for an accepted package.
+	Result *AcceptanceAuditPackageResults
+}
+
+// isDangerousGoodsRejection reports whether UPS rejected the dangerous goods
+// of the package. UPS does not publish the error codes of the acceptance
+// audit, so a rejection is told apart by the HTTP status: the audit answers
+// 400 Bad Request with the reasons in the error response. Authorization
+// errors, rate limits and server errors mean that the package could not be
+// checked.
+func isDangerousGoodsRejection(e *ErrorResponse) bool {
+	return e.StatusCode == http.StatusBadRequest && len(e.Errors) > 0
+}
+
+// CheckDangerousGoods runs the acceptance audit pre-check for every package
+// of the shipment containing HazMat. Each package is checked on its own, so
+// that errors can be assigned to the package. A package is reported as
+// rejected if UPS answers with 400 Bad Request and an error response. All
+// other errors, e.g. connection errors, authorization errors, rate limits or
+// server errors, are returned.
+func (c *Client) CheckDangerousGoods(ctx context.Context, shipment Shipment) ([]PackageAcceptance, error) {
+	var results []PackageAcceptance
+
+	for i, p := range shipment.Packages {
+		if p.PackageServiceOptions == nil || len(p.PackageServiceOptions.HazMats) == 0 {
+			continue
+		}
+
+		request := shipment.acceptanceAuditPreCheckRequest(i)
+		acceptance := PackageAcceptance{
+			Index:             i,
+			PackageIdentifier: request.Shipment.Packages[0].PackageIdentifier,
+		}
+
+		response, err := c.AcceptanceAuditPreCheck(ctx, request)
+
+		var errorResponse *ErrorResponse
+		switch {
+		case errors.As(err, &errorResponse) && isDangerousGoodsRejection(errorResponse):
+			acceptance.Errors = errorResponse.Errors
+		case err != nil:
+			return results, fmt.Errorf("package %d: %w", i, err)
+		default:
+			acceptance.Accepted = true
+			acceptance.Alerts = response.Response.Alerts
+
+			if len(response.PackageResults) > 0 {
+				acceptance.Result = &response.PackageResults[0]
+			}
+		}
+
+		results = append(results, acceptance)
+	}
+
+	return results, nil
+}
+
+// acceptanceAuditPreCheckRequest builds the pre-check request for the package
+// with the given index.
+func (s *Shipment) acceptanceAuditPreCheckRequest(index int) AcceptanceAuditPreCheckRequest {
+	p := s.Packages[index]
+	hazMats := p.PackageServiceOptions.HazMats
+
+	identifier := p.PackageServiceOptions.PackageIdentifier
+	if identifier == "" {
+		identifier = strconv.Itoa(index + 1)
+	}
+
+	pkg := AcceptanceAuditPackage{
+		PackageIdentifier:  identifier,
+		PackageWeight:      p.PackageWeight,
+		TransportationMode: hazMats[0].TransportationMode,
+		EmergencyPhone:     hazMats[0].EmergencyPhone,
+		EmergencyContact:   hazMats[0].EmergencyContact,
+		ChemicalRecords:    hazMats,
+	}
+
+	if info := p.HazMatPackageInformation; info != nil {
+		pkg.QValue = info.QValue
+		pkg.OverPackedIndicator = info.OverPackedIndicator
+		pkg.AllPackedInOneIndicator = info.AllPackedInOneIndicator
+	}
+
+	shipFrom := DangerousGoodsAddress{
+		AddressLines:      s.Shipper.Address.AddressLines,
+		City:              s.Shipper.Address.City,
+		StateProvinceCode: s.Shipper.Address.StateProvinceCode,
+		PostalCode:        s.Shipper.Address.PostalCode,
+		CountryCode:       s.Shipper.Address.CountryCode,
+	}
+	if s.ShipFrom != nil {
+		shipFrom = dangerousGoodsAddress(s.ShipFrom.Address)
+	}
+
+	return AcceptanceAuditPreCheckRequest{
+		OriginRecordTransactionTimestamp: time.Now().Format("2006-01-02T15:04:05"),
+		Shipment: AcceptanceAuditShipment{
+			ShipperNumber:   s.Shipper.ShipperNumber,
+			ShipFromAddress: shipFrom,
+			ShipToAddress:   dangerousGoodsAddress(s.ShipTo.Address),
+			Service:         s.Service,
+			RegulationSet:   hazMats[0].RegulationSet,
+			Packages:        []AcceptanceAuditPackage{pkg},
+		},
+	}
+}
+
+func dangerousGoodsAddress(address ShipToAddress) DangerousGoodsAddress {
+	return DangerousGoodsAddress{
+		AddressLines:      address.AddressLines,
+		City:              address.City,
+		StateProvinceCode: address.StateProvinceCode,
+		PostalCode:        address.PostalCode,
+		CountryCode:       address.CountryCode,
+	}
+}
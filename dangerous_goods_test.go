@@ -0,0 +1,214 @@
+package ups
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"slices"
+	"testing"
+)
+
+// newTestClient returns a client sending its requests to handler.
+func newTestClient(t *testing.T, handler http.HandlerFunc) *Client {
+	t.Helper()
+
+	server := httptest.NewServer(handler)
+	t.Cleanup(server.Close)
+
+	return New(WithEnvironment(Environment(server.URL)))
+}
+
+func writeErrorResponse(w http.ResponseWriter, status int, codes ...string) {
+	var response ErrorResponse
+	for _, code := range codes {
+		response.Errors = append(response.Errors, Error{Code: code, Message: "error " + code})
+	}
+
+	w.WriteHeader(status)
+	json.NewEncoder(w).Encode(map[string]any{"response": response})
+}
+
+func TestCheckDangerousGoods(t *testing.T) {
+	hazMat := &PackageServiceOptions{HazMats: []HazMat{{
+		IDNumber:                    "UN3481",
+		RegulationSet:               "ADR",
+		TransportationMode:          "GND",
+		CommodityRegulatedLevelCode: "LQ",
+	}}}
+
+	shipment := testShipment("DE", "DE")
+	shipment.Packages = []Package{
+		{PackageServiceOptions: hazMat},
+		{},
+		{PackageServiceOptions: hazMat},
+	}
+
+	accepted := func(w http.ResponseWriter) {
+		w.Write([]byte(`{"AcceptanceAuditPreCheckResponse":{"Response":{"ResponseStatus":{"Code":"1"}},"PackageResults":{"PackageIdentifier":"1"}}}`))
+	}
+
+	// The error body follows the error format documented for the UPS REST
+	// APIs. UPS does not publish the codes of the acceptance audit, so the
+	// codes are only placeholders.
+	rejected := func(w http.ResponseWriter) {
+		w.WriteHeader(http.StatusBadRequest)
+		w.Write([]byte(`{"response":{"errors":[{"code":"DG1","message":"The quantity exceeds the limit for the transportation mode."},{"code":"DG2","message":"The chemical record is forbidden for the service."}]}}`))
+	}
+
+	tests := []struct {
+		name         string
+		responses    []func(w http.ResponseWriter)
+		wantAccepted []bool
+		wantErrors   [][]string
+		wantErr      bool
+		wantStatus   int
+	}{
+		{
+			name:         "accepted",
+			responses:    []func(w http.ResponseWriter){accepted, accepted},
+			wantAccepted: []bool{true, true},
+			wantErrors:   [][]string{nil, nil},
+		},
+		{
+			name:         "rejected package",
+			responses:    []func(w http.ResponseWriter){rejected, accepted},
+			wantAccepted: []bool{false, true},
+			wantErrors:   [][]string{{"DG1", "DG2"}, nil},
+		},
+		{
+			name: "unauthorized",
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) { writeErrorResponse(w, http.StatusUnauthorized, "250002") },
+			},
+			wantErr:    true,
+			wantStatus: http.StatusUnauthorized,
+		},
+		{
+			name: "forbidden",
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) { writeErrorResponse(w, http.StatusForbidden, "250003") },
+			},
+			wantErr:    true,
+			wantStatus: http.StatusForbidden,
+		},
+		{
+			name: "rate limit",
+			responses: []func(w http.ResponseWriter){
+				accepted,
+				func(w http.ResponseWriter) { writeErrorResponse(w, http.StatusTooManyRequests, "429") },
+			},
+			wantAccepted: []bool{true},
+			wantErrors:   [][]string{nil},
+			wantErr:      true,
+			wantStatus:   http.StatusTooManyRequests,
+		},
+		{
+			name: "server error",
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) { writeErrorResponse(w, http.StatusInternalServerError, "10002") },
+			},
+			wantErr:    true,
+			wantStatus: http.StatusInternalServerError,
+		},
+		{
+			name: "empty error response",
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) { writeErrorResponse(w, http.StatusBadRequest) },
+			},
+			wantErr:    true,
+			wantStatus: http.StatusBadRequest,
+		},
+		{
+			name: "connection error",
+			responses: []func(w http.ResponseWriter){
+				func(w http.ResponseWriter) { panic(http.ErrAbortHandler) },
+			},
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			requests := 0
+
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != dangerousGoodsURL+"/acceptanceauditprecheck" {
+					t.Errorf("request to %s", r.URL.Path)
+				}
+
+				requests++
+				tt.responses[requests-1](w)
+			})
+
+			results, err := c.CheckDangerousGoods(context.Background(), shipment)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("CheckDangerousGoods() error = %v, want error %t", err, tt.wantErr)
+			}
+
+			var errorResponse *ErrorResponse
+			if errors.As(err, &errorResponse) != (tt.wantStatus != 0) || (errorResponse != nil && errorResponse.StatusCode != tt.wantStatus) {
+				t.Errorf("CheckDangerousGoods() error = %#v, want ErrorResponse with status %d", err, tt.wantStatus)
+			}
+
+			if len(results) != len(tt.wantAccepted) {
+				t.Fatalf("CheckDangerousGoods() = %d results, want %d", len(results), len(tt.wantAccepted))
+			}
+
+			for i, result := range results {
+				if result.Index != 2*i {
+					t.Errorf("result %d Index = %d, want %d", i, result.Index, 2*i)
+				}
+
+				if result.Accepted != tt.wantAccepted[i] {
+					t.Errorf("result %d Accepted = %t, want %t", i, result.Accepted, tt.wantAccepted[i])
+				}
+
+				var codes []string
+				for _, e := range result.Errors {
+					codes = append(codes, e.Code)
+				}
+
+				if !slices.Equal(codes, tt.wantErrors[i]) {
+					t.Errorf("result %d errors = %v, want %v", i, codes, tt.wantErrors[i])
+				}
+			}
+		})
+	}
+}
+
+func TestChemicalReferenceData(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		var request struct {
+			ChemicalReferenceDataRequest ChemicalReferenceDataRequest
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Fatal(err)
+		}
+
+		if got := request.ChemicalReferenceDataRequest.IDNumber; got != "UN3481" {
+			t.Errorf("IDNumber = %q, want UN3481", got)
+		}
+
+		w.Write([]byte(`{"ChemicalReferenceDataResponse":{"Response":{"ResponseStatus":{"Code":"1"}},"ChemicalData":{"ChemicalDetail":{"RegulationSet":"ADR","IDNumber":"UN3481"},"PackageQuantityLimitDetail":{"PackageQuantityLimitTypeCode":"GND"}}}}`))
+	})
+
+	if _, err := c.ChemicalReferenceData(context.Background(), ChemicalReferenceDataRequest{}); err == nil {
+		t.Error("ChemicalReferenceData() without IDNumber and ProperShippingName error = nil")
+	}
+
+	response, err := c.ChemicalReferenceData(context.Background(), ChemicalReferenceDataRequest{IDNumber: "UN3481"})
+	if err != nil {
+		t.Fatalf("ChemicalReferenceData() error = %v", err)
+	}
+
+	if len(response.ChemicalData) != 1 || len(response.ChemicalData[0].PackageQuantityLimitDetail) != 1 {
+		t.Fatalf("ChemicalReferenceData() = %+v, want one chemical record with one limit", response)
+	}
+
+	if got := response.ChemicalData[0].ChemicalDetail.RegulationSet; got != "ADR" {
+		t.Errorf("RegulationSet = %q, want ADR", got)
+	}
+}
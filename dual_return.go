@@ -0,0 +1,183 @@
+package ups
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// Phases of a Mail Innovations Dual Return Shipment.
+const (
+	// DualReturnPhaseValidation checks both requests before anything is
+	// sent to UPS.
+	DualReturnPhaseValidation = iota
+	// DualReturnPhasePackage is the first phase, creating the UPS package
+	// return shipment.
+	DualReturnPhasePackage
+	// DualReturnPhaseMailInnovations is the second phase, creating the Mail
+	// Innovations return shipment.
+	DualReturnPhaseMailInnovations
+)
+
+// mailInnovationsServices are the services of the Mail Innovations request.
+var mailInnovationsServices = []ServiceCode{
+	ServiceExpeditedMailInnovations,
+	ServicePriorityMailInnovations,
+	ServiceEconomyMailInnovations,
+	ServiceMailInnovationsReturns,
+}
+
+// ErrNoDualReturnKey is returned if UPS did not return a
+// MIDualReturnShipmentKey in the first phase.
+var ErrNoDualReturnKey = errors.New("no MIDualReturnShipmentKey in response")
+
+// DualReturnError is returned by Client.CreateDualReturn and names the phase
+// which failed.
+type DualReturnError struct {
+	// Phase is DualReturnPhaseValidation, DualReturnPhasePackage or
+	// DualReturnPhaseMailInnovations.
+	Phase int
+	// Key is the MIDualReturnShipmentKey if the first phase succeeded.
+	Key string
+	Err error
+}
+
+func (e *DualReturnError) Error() string {
+	switch e.Phase {
+	case DualReturnPhaseValidation:
+		return fmt.Sprintf("dual return validation: %s", e.Err)
+	case DualReturnPhasePackage:
+		return fmt.Sprintf("dual return package phase: %s", e.Err)
+	case DualReturnPhaseMailInnovations:
+		return fmt.Sprintf("dual return mail innovations phase (key %s): %s", e.Key, e.Err)
+	}
+
+	return fmt.Sprintf("dual return phase %d: %s", e.Phase, e.Err)
+}
+
+func (e *DualReturnError) Unwrap() error {
+	return e.Err
+}
+
+// DualReturn is the result of a Mail Innovations Dual Return Shipment.
+type DualReturn struct {
+	// Key linking both phases.
+	Key string
+	// Response of the UPS package return shipment.
+	Package *ShipmentResponse
+	// Response of the Mail Innovations return shipment.
+	MailInnovations *ShipmentResponse
+}
+
+// PackageLabel returns the label of the UPS package return shipment.
+func (r *DualReturn) PackageLabel() *ShippingLabel {
+	return firstLabel(r.Package)
+}
+
+// MailInnovationsLabel returns the label of the Mail Innovations return
+// shipment.
+func (r *DualReturn) MailInnovationsLabel() *ShippingLabel {
+	return firstLabel(r.MailInnovations)
+}
+
+func firstLabel(response *ShipmentResponse) *ShippingLabel {
+	if response == nil {
+		return nil
+	}
+
+	for _, result := range response.ShipmentResults.PackageResults {
+		if result.ShippingLabel != nil {
+			return result.ShippingLabel
+		}
+	}
+
+	return nil
+}
+
+// CreateDualReturn creates a Mail Innovations Dual Return Shipment. The
+// package request is sent first with MIDualReturnShipmentIndicator, then the
+// returned key is set in the Mail Innovations request, which is sent second.
+// The Mail Innovations service defaults to ServiceMailInnovationsReturns.
+// Both requests are checked before the first phase, so that no package
+// return shipment is created for a Mail Innovations request UPS would
+// reject. Failures are returned as *DualReturnError. If the second phase
+// fails, the result of the first phase is returned as well.
+func (c *Client) CreateDualReturn(ctx context.Context, packageRequest, mailInnovationsRequest ShipmentRequest) (*DualReturn, error) {
+	err := validateDualReturnRequests(packageRequest.Shipment, mailInnovationsRequest.Shipment)
+	if err != nil {
+		return nil, &DualReturnError{Phase: DualReturnPhaseValidation, Err: err}
+	}
+
+	packageRequest.Shipment.MIDualReturnShipmentIndicator = " "
+	packageRequest.Shipment.MIDualReturnShipmentKey = ""
+
+	response, err := c.CreateShipment(ctx, packageRequest)
+	if err != nil {
+		return nil, &DualReturnError{Phase: DualReturnPhasePackage, Err: err}
+	}
+
+	result := &DualReturn{
+		Key:     response.ShipmentResults.MIDualReturnShipmentKey,
+		Package: response,
+	}
+
+	if result.Key == "" {
+		return result, &DualReturnError{Phase: DualReturnPhasePackage, Err: ErrNoDualReturnKey}
+	}
+
+	if mailInnovationsRequest.Shipment.Service.Code == "" {
+		mailInnovationsRequest.Shipment.Service.Code = string(ServiceMailInnovationsReturns)
+	}
+
+	// The indicator is not valid with the Mail Innovations returns service.
+	mailInnovationsRequest.Shipment.MIDualReturnShipmentIndicator = ""
+	mailInnovationsRequest.Shipment.MIDualReturnShipmentKey = result.Key
+
+	response, err = c.CreateShipment(ctx, mailInnovationsRequest)
+	if err != nil {
+		return result, &DualReturnError{Phase: DualReturnPhaseMailInnovations, Key: result.Key, Err: err}
+	}
+
+	result.MailInnovations = response
+
+	return result, nil
+}
+
+// validateDualReturnRequests checks that both shipments are returns and that
+// the Mail Innovations shipment uses a Mail Innovations service.
+func validateDualReturnRequests(packageShipment, mailInnovationsShipment Shipment) error {
+	var errs []error
+
+	if !packageShipment.IsReturn() {
+		errs = append(errs, newValidationError("packageRequest.Shipment.ReturnService", "required for a dual return"))
+	}
+
+	if !mailInnovationsShipment.IsReturn() {
+		errs = append(errs, newValidationError("mailInnovationsRequest.Shipment.ReturnService", "required for a dual return"))
+	}
+
+	if code := mailInnovationsShipment.Service.Code; code != "" && !slices.Contains(mailInnovationsServices, ServiceCode(code)) {
+		errs = append(errs, newValidationError("mailInnovationsRequest.Shipment.Service.Code", "%s is not a Mail Innovations service", code))
+	}
+
+	return errors.Join(errs...)
+}
+
+func (s *Shipment) validateDualReturn() []error {
+	if s.MIDualReturnShipmentIndicator == "" {
+		return nil
+	}
+
+	var errs []error
+
+	if !s.IsReturn() {
+		errs = append(errs, newValidationError("Shipment.MIDualReturnShipmentIndicator", "only valid for return shipments"))
+	}
+
+	if ServiceCode(s.Service.Code) == ServiceMailInnovationsReturns {
+		errs = append(errs, newValidationError("Shipment.MIDualReturnShipmentIndicator", "not valid with the Mail Innovations returns service"))
+	}
+
+	return errs
+}
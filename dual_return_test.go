@@ -0,0 +1,233 @@
+package ups
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestCreateDualReturn(t *testing.T) {
+	const (
+		withKey    = `{"ShipmentResponse":{"Response":{"ResponseStatus":{"Code":"1"}},"ShipmentResults":{"MIDualReturnShipmentKey":"KEY1","PackageResults":{"TrackingNumber":"1Z999AA10123456784","ShippingLabel":{"ImageFormat":{"Code":"GIF"},"GraphicImage":"R0lGODlh"}}}}}`
+		withoutKey = `{"ShipmentResponse":{"Response":{"ResponseStatus":{"Code":"1"}},"ShipmentResults":{"PackageResults":{"TrackingNumber":"92419900000033499522966220","ShippingLabel":{"ImageFormat":{"Code":"GIF"},"GraphicImage":"R0lGODlh"}}}}}`
+		rejected   = `{"response":{"errors":[{"code":"120100","message":"Missing or invalid shipper number"}]}}`
+	)
+
+	tests := []struct {
+		name                string
+		responses           []string
+		wantErr             bool
+		wantPhase           int
+		wantKey             string
+		wantPackage         bool
+		wantMailInnovations bool
+	}{
+		{
+			name:                "both phases",
+			responses:           []string{withKey, withoutKey},
+			wantKey:             "KEY1",
+			wantPackage:         true,
+			wantMailInnovations: true,
+		},
+		{
+			name:      "package phase failed",
+			responses: []string{rejected},
+			wantErr:   true,
+			wantPhase: DualReturnPhasePackage,
+		},
+		{
+			name:        "no key",
+			responses:   []string{withoutKey},
+			wantErr:     true,
+			wantPhase:   DualReturnPhasePackage,
+			wantPackage: true,
+		},
+		{
+			name:        "mail innovations phase failed",
+			responses:   []string{withKey, rejected},
+			wantErr:     true,
+			wantPhase:   DualReturnPhaseMailInnovations,
+			wantKey:     "KEY1",
+			wantPackage: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var requests []Shipment
+
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				var request struct {
+					ShipmentRequest ShipmentRequest
+				}
+
+				if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+					t.Fatal(err)
+				}
+
+				requests = append(requests, request.ShipmentRequest.Shipment)
+				response := tt.responses[len(requests)-1]
+				if response == rejected {
+					w.WriteHeader(http.StatusBadRequest)
+				}
+
+				w.Write([]byte(response))
+			})
+
+			packageRequest := ShipmentRequest{Shipment: testShipment("US", "US")}
+			packageRequest.Shipment.MIDualReturnShipmentKey = "stale"
+			packageRequest.Shipment.ReturnService = &ReturnService{Code: ReturnServicePrintReturnLabel}
+
+			mailInnovationsRequest := ShipmentRequest{Shipment: testShipment("US", "US")}
+			mailInnovationsRequest.Shipment.MIDualReturnShipmentIndicator = " "
+			mailInnovationsRequest.Shipment.ReturnService = &ReturnService{Code: ReturnServicePrintReturnLabel}
+
+			result, err := c.CreateDualReturn(context.Background(), packageRequest, mailInnovationsRequest)
+
+			var dualReturnErr *DualReturnError
+			if !tt.wantErr && err != nil {
+				t.Fatalf("CreateDualReturn() error = %v", err)
+			} else if tt.wantErr && (!errors.As(err, &dualReturnErr) || dualReturnErr.Phase != tt.wantPhase) {
+				t.Fatalf("CreateDualReturn() error = %v, want phase %d", err, tt.wantPhase)
+			}
+
+			if len(requests) != len(tt.responses) {
+				t.Fatalf("CreateDualReturn() sent %d requests, want %d", len(requests), len(tt.responses))
+			}
+
+			if len(requests) > 0 && (requests[0].MIDualReturnShipmentIndicator == "" || requests[0].MIDualReturnShipmentKey != "") {
+				t.Errorf("package request = %+v, want indicator without key", requests[0])
+			}
+
+			if len(requests) > 1 {
+				mi := requests[1]
+				if mi.MIDualReturnShipmentIndicator != "" || mi.MIDualReturnShipmentKey != tt.wantKey || ServiceCode(mi.Service.Code) != ServiceMailInnovationsReturns {
+					t.Errorf("mail innovations request = %+v, want key %s and service %s", mi, tt.wantKey, ServiceMailInnovationsReturns)
+				}
+			}
+
+			if (result != nil && result.Package != nil) != tt.wantPackage {
+				t.Errorf("CreateDualReturn() = %+v, want package response %t", result, tt.wantPackage)
+			}
+
+			if result == nil {
+				return
+			}
+
+			if result.Key != tt.wantKey {
+				t.Errorf("Key = %q, want %q", result.Key, tt.wantKey)
+			}
+
+			if (result.MailInnovations != nil) != tt.wantMailInnovations {
+				t.Errorf("MailInnovations = %+v, want response %t", result.MailInnovations, tt.wantMailInnovations)
+			}
+
+			if result.PackageLabel() == nil {
+				t.Error("PackageLabel() = nil")
+			}
+
+			if (result.MailInnovationsLabel() != nil) != tt.wantMailInnovations {
+				t.Errorf("MailInnovationsLabel() = %+v, want label %t", result.MailInnovationsLabel(), tt.wantMailInnovations)
+			}
+		})
+	}
+}
+
+func TestCreateDualReturnValidation(t *testing.T) {
+	tests := []struct {
+		name                  string
+		packageReturn         bool
+		mailInnovationsReturn bool
+		service               ServiceCode
+		want                  []string
+	}{
+		{
+			name:          "mail innovations request without return service",
+			packageReturn: true,
+			want:          []string{"mailInnovationsRequest.Shipment.ReturnService"},
+		},
+		{
+			name:                  "package request without return service",
+			mailInnovationsReturn: true,
+			service:               ServiceMailInnovationsReturns,
+			want:                  []string{"packageRequest.Shipment.ReturnService"},
+		},
+		{
+			name:                  "no mail innovations service",
+			packageReturn:         true,
+			mailInnovationsReturn: true,
+			service:               ServiceGround,
+			want:                  []string{"mailInnovationsRequest.Shipment.Service.Code"},
+		},
+		{
+			name:    "forward shipments",
+			service: ServiceGround,
+			want: []string{
+				"packageRequest.Shipment.ReturnService",
+				"mailInnovationsRequest.Shipment.ReturnService",
+				"mailInnovationsRequest.Shipment.Service.Code",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				t.Errorf("CreateDualReturn() sent a request to %s", r.URL.Path)
+			})
+
+			packageRequest := ShipmentRequest{Shipment: testShipment("US", "US")}
+			if tt.packageReturn {
+				packageRequest.Shipment.ReturnService = &ReturnService{Code: ReturnServicePrintReturnLabel}
+			}
+
+			mailInnovationsRequest := ShipmentRequest{Shipment: testShipment("US", "US")}
+			mailInnovationsRequest.Shipment.Service.Code = string(tt.service)
+			if tt.mailInnovationsReturn {
+				mailInnovationsRequest.Shipment.ReturnService = &ReturnService{Code: ReturnServicePrintReturnLabel}
+			}
+
+			result, err := c.CreateDualReturn(context.Background(), packageRequest, mailInnovationsRequest)
+
+			var dualReturnErr *DualReturnError
+			if !errors.As(err, &dualReturnErr) || dualReturnErr.Phase != DualReturnPhaseValidation {
+				t.Fatalf("CreateDualReturn() error = %v, want phase %d", err, DualReturnPhaseValidation)
+			}
+
+			if result != nil {
+				t.Errorf("CreateDualReturn() = %+v, want nil", result)
+			}
+
+			checkValidationFields(t, dualReturnErr.Err, tt.want)
+		})
+	}
+}
+
+func TestValidateDualReturn(t *testing.T) {
+	tests := []struct {
+		name    string
+		returns bool
+		service ServiceCode
+		want    []string
+	}{
+		{"return", true, ServiceGround, nil},
+		{"forward shipment", false, ServiceGround, []string{"Shipment.MIDualReturnShipmentIndicator"}},
+		{"mail innovations returns", true, ServiceMailInnovationsReturns, []string{"Shipment.MIDualReturnShipmentIndicator"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := testShipment("US", "US")
+			s.Service.Code = string(tt.service)
+			s.MIDualReturnShipmentIndicator = " "
+
+			if tt.returns {
+				s.ReturnService = &ReturnService{Code: ReturnServicePrintReturnLabel}
+			}
+
+			checkValidationFields(t, errors.Join(s.validateDualReturn()...), tt.want)
+		})
+	}
+}
@@ -4,6 +4,8 @@ import "fmt"
 
 type ErrorResponse struct {
 	Errors []Error `json:"errors"`
+	// HTTP status code of the response.
+	StatusCode int `json:"-"`
 }
 
 type Error struct {
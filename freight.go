@@ -0,0 +1,118 @@
+package ups
+
+import (
+	"fmt"
+	"slices"
+)
+
+// Handling unit types of HandlingUnitType.Code.
+const (
+	HandlingUnitTypeSkid   = "SKD"
+	HandlingUnitTypeCarboy = "CBY"
+	HandlingUnitTypePallet = "PLT"
+	HandlingUnitTypeTotes  = "TOT"
+	HandlingUnitTypeLoose  = "LOO"
+	HandlingUnitTypeOther  = "OTH"
+)
+
+// Ground Freight Pricing payment types of FRSPaymentInformationType.Code.
+const (
+	FRSPaymentTypePrepaid        = "01"
+	FRSPaymentTypeFreightCollect = "02"
+	FRSPaymentTypeThirdParty     = "03"
+)
+
+var handlingUnitTypes = []string{
+	HandlingUnitTypeSkid, HandlingUnitTypeCarboy, HandlingUnitTypePallet, HandlingUnitTypeTotes, HandlingUnitTypeLoose,
+	HandlingUnitTypeOther,
+}
+
+// IsGroundFreightPricing reports whether the shipment is a Ground Freight
+// Pricing shipment.
+func (s *Shipment) IsGroundFreightPricing() bool {
+	return s.FRSPaymentInformation != nil
+}
+
+func (s *Shipment) validateFreight() []error {
+	var errs []error
+
+	if p := s.FRSPaymentInformation; p != nil {
+		if s.PaymentInformation != nil {
+			errs = append(errs, newValidationError("Shipment.PaymentInformation", "not valid for Ground Freight Pricing shipments"))
+		}
+
+		switch p.Type.Code {
+		case FRSPaymentTypePrepaid, FRSPaymentTypeFreightCollect, FRSPaymentTypeThirdParty:
+		default:
+			errs = append(errs, newValidationError("Shipment.FRSPaymentInformation.Type.Code", "%q is not valid, use 01, 02 or 03", p.Type.Code))
+		}
+
+		if p.Type.Code != FRSPaymentTypePrepaid && p.Address == nil {
+			errs = append(errs, newValidationError("Shipment.FRSPaymentInformation.Address", "is required for freight collect and third party"))
+		}
+
+		for i, pkg := range s.Packages {
+			if pkg.Commodity == nil {
+				errs = append(errs, newValidationError(fmt.Sprintf("Shipment.Package[%d].Commodity", i), "is required for Ground Freight Pricing shipments"))
+			}
+		}
+	}
+
+	info := s.FreightShipmentInformation
+	if info == nil {
+		return errs
+	}
+
+	if !s.IsGroundFreightPricing() {
+		errs = append(errs, newValidationError("Shipment.FreightShipmentInformation", "requires FRSPaymentInformation"))
+	}
+
+	density := info.FreightDensityInfo
+	if density == nil {
+		if info.DensityEligibleIndicator != "" {
+			errs = append(errs, newValidationError("Shipment.FreightShipmentInformation.FreightDensityInfo", "is required for density based rating"))
+		}
+
+		return errs
+	}
+
+	field := "Shipment.FreightShipmentInformation.FreightDensityInfo"
+
+	if density.AdjustedHeightIndicator != "" {
+		if h := density.AdjustedHeight; h == nil {
+			errs = append(errs, newValidationError(field+".AdjustedHeight", "is required if AdjustedHeightIndicator is present"))
+		} else {
+			if h.UnitOfMeasurement.Code != "IN" {
+				errs = append(errs, newValidationError(field+".AdjustedHeight.UnitOfMeasurement.Code", "%q is not valid, use IN", h.UnitOfMeasurement.Code))
+			}
+
+			if err := validateDecimal(field+".AdjustedHeight.Value", h.Value, 0); err != nil {
+				errs = append(errs, err)
+			}
+		}
+	} else if density.AdjustedHeight != nil {
+		errs = append(errs, newValidationError(field+".AdjustedHeight", "requires AdjustedHeightIndicator"))
+	}
+
+	if len(density.HandlingUnits) == 0 {
+		errs = append(errs, newValidationError(field+".HandlingUnits", "at least one handling unit is required"))
+	}
+
+	for i, unit := range density.HandlingUnits {
+		unitField := fmt.Sprintf("%s.HandlingUnits[%d]", field, i)
+
+		if !slices.Contains(handlingUnitTypes, unit.Type.Code) {
+			errs = append(errs, newValidationError(unitField+".Type.Code", "%q is not a valid handling unit type", unit.Type.Code))
+		}
+
+		if err := validateDecimal(unitField+".Quantity", unit.Quantity, 0); err != nil {
+			errs = append(errs, err)
+		}
+
+		if unit.Dimensions.UnitOfMeasurement.Code != "IN" {
+			errs = append(errs, newValidationError(unitField+".Dimensions.UnitOfMeasurement.Code", "%q is not valid, use IN", unit.Dimensions.UnitOfMeasurement.Code))
+		}
+	}
+
+	return errs
+}
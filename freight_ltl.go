@@ -0,0 +1,712 @@
+package ups
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+)
+
+// LTL freight services of FreightService.Code.
+const (
+	FreightServiceLTL           = "308"
+	FreightServiceLTLGuaranteed = "309"
+	FreightServiceGuaranteedAM  = "334"
+	FreightServiceStandardLTL   = "349"
+)
+
+// Billing options of FreightShipmentBillingOption.Code.
+const (
+	FreightBillingPrepaid        = "10"
+	FreightBillingThirdParty     = "30"
+	FreightBillingFreightCollect = "40"
+)
+
+// Document types of FreightDocumentType.Code.
+const (
+	FreightDocumentBOL   = "20"
+	FreightDocumentLabel = "30"
+)
+
+// ErrNoBOL is returned if the freight shipment response contains no bill of
+// lading.
+var ErrNoBOL = errors.New("no bill of lading in freight documents")
+
+var freightServices = []string{FreightServiceLTL, FreightServiceLTLGuaranteed, FreightServiceGuaranteedAM, FreightServiceStandardLTL}
+
+type FreightRequest struct {
+	// Enables the user to specify optional processing.
+	RequestOption string `json:",omitempty"`
+	// TransactionReference identifies transactions between client and
+	// server.
+	TransactionReference *TransactionReference `json:",omitempty"`
+}
+
+// FreightShipment contains the shipment data which is shared by rating and
+// shipping of LTL freight.
+type FreightShipment struct {
+	// Location the freight is picked up.
+	ShipFrom FreightParty
+	// Shipper's six digit account number.
+	ShipperNumber string `validate:"len=6"`
+	// Location the freight is delivered to.
+	ShipTo FreightParty
+	// Payment information container.
+	PaymentInformation FreightPaymentInformation
+	// Freight service.
+	Service FreightService
+	// First handling unit type and quantity, e.g. the number of pallets.
+	HandlingUnitOne *FreightHandlingUnit `json:",omitempty"`
+	// Second handling unit type and quantity.
+	HandlingUnitTwo *FreightHandlingUnit `json:",omitempty"`
+	// Commodities of the shipment.
+	Commodities []FreightCommodity `json:"Commodity" validate:"required,dive"`
+	// Accessorials of the shipment.
+	ShipmentServiceOptions *FreightShipmentServiceOptions `json:",omitempty"`
+}
+
+type FreightParty struct {
+	Name          string `validate:"min=1,max=35"`
+	AttentionName string `json:",omitempty" validate:"max=35"`
+	Phone         *Phone `json:",omitempty"`
+	EMailAddress  string `json:",omitempty" validate:"max=50"`
+	Address       ShipToAddress
+}
+
+// FreightPartyFromShipper returns the shipper as freight party.
+func FreightPartyFromShipper(shipper Shipper) FreightParty {
+	return FreightParty{
+		Name:          shipper.Name,
+		AttentionName: shipper.AttentionName,
+		Phone:         shipper.Phone,
+		EMailAddress:  shipper.EMailAddress,
+		Address: ShipToAddress{
+			AddressLines:      shipper.Address.AddressLines,
+			City:              shipper.Address.City,
+			StateProvinceCode: shipper.Address.StateProvinceCode,
+			PostalCode:        shipper.Address.PostalCode,
+			CountryCode:       shipper.Address.CountryCode,
+		},
+	}
+}
+
+// FreightPartyFromShipTo returns the receiver as freight party.
+func FreightPartyFromShipTo(shipTo ShipTo) FreightParty {
+	return FreightParty{
+		Name:          shipTo.Name,
+		AttentionName: shipTo.AttentionName,
+		Phone:         shipTo.Phone,
+		EMailAddress:  shipTo.EMailAddress,
+		Address:       shipTo.Address,
+	}
+}
+
+type FreightPaymentInformation struct {
+	// The party paying the freight charges.
+	Payer FreightParty
+	// Billing option container.
+	ShipmentBillingOption FreightShipmentBillingOption
+}
+
+type FreightShipmentBillingOption struct {
+	// Valid values: 10 = Prepaid, 30 = Bill to Third Party, 40 = Freight
+	// Collect.
+	Code string `validate:"len=2"`
+	// Description of the billing option.
+	Description string `json:",omitempty" validate:"max=50"`
+}
+
+type FreightService struct {
+	// Valid values: 308 = LTL, 309 = LTL Guaranteed, 334 = LTL Guaranteed
+	// A.M., 349 = Standard LTL.
+	Code string `validate:"len=3"`
+	// Description of the service.
+	Description string `json:",omitempty" validate:"max=50"`
+}
+
+type FreightHandlingUnit struct {
+	// Number of handling units.
+	Quantity string `validate:"min=1,max=4"`
+	// Type of the handling units.
+	Type HandlingUnitType
+}
+
+type FreightCommodity struct {
+	// Description of the commodity.
+	Description string `validate:"min=1,max=100"`
+	// Weight of the commodity.
+	Weight FreightWeight
+	// Dimensions of the commodity.
+	Dimensions *HandlingUnitDimensions `json:",omitempty"`
+	// Number of pieces of the commodity.
+	NumberOfPieces string `json:",omitempty" validate:"max=5"`
+	// Packaging type of the pieces.
+	PackagingType *HandlingUnitType `json:",omitempty"`
+	// NMFC freight class of the commodity.
+	FreightClass string `validate:"min=2,max=10"`
+	// NMFC commodity code container.
+	NMFCCommodity *NMFC `json:",omitempty"`
+	// Indicates that the commodity contains dangerous goods.
+	DangerousGoodsIndicator string `json:",omitempty"`
+}
+
+type FreightWeight struct {
+	UnitOfMeasurement FreightWeightUnitOfMeasurement
+	Value             string `validate:"min=1,max=13"`
+}
+
+type FreightWeightUnitOfMeasurement struct {
+	// Valid values: LBS = Pounds, KGS = Kilograms.
+	Code string `validate:"len=3"`
+	// Description of the unit.
+	Description string `json:",omitempty" validate:"max=35"`
+}
+
+type FreightShipmentServiceOptions struct {
+	// Accessorials at the pickup location.
+	PickupOptions *FreightPickupOptions `json:",omitempty"`
+	// Accessorials at the delivery location.
+	DeliveryOptions *FreightDeliveryOptions `json:",omitempty"`
+}
+
+type FreightPickupOptions struct {
+	// A lift gate is required at pickup.
+	LiftGateRequiredIndicator string `json:",omitempty"`
+	// The pickup location is residential.
+	ResidentialPickupIndicator string `json:",omitempty"`
+	// The freight is picked up inside the building.
+	InsidePickupIndicator string `json:",omitempty"`
+	// The pickup location has limited access.
+	LimitedAccessPickupIndicator string `json:",omitempty"`
+}
+
+type FreightDeliveryOptions struct {
+	// A lift gate is required at delivery.
+	LiftGateRequiredIndicator string `json:",omitempty"`
+	// The delivery location is residential.
+	ResidentialDeliveryIndicator string `json:",omitempty"`
+	// The freight is delivered inside the building.
+	InsideDeliveryIndicator string `json:",omitempty"`
+	// The delivery location has limited access.
+	LimitedAccessDeliveryIndicator string `json:",omitempty"`
+	// The carrier calls the receiver before delivery.
+	CallBeforeDeliveryIndicator string `json:",omitempty"`
+}
+
+// Validate checks the freight shipment for errors UPS would reject.
+func (s *FreightShipment) Validate() error {
+	var errs []error
+
+	if !slices.Contains(freightServices, s.Service.Code) {
+		errs = append(errs, newValidationError("Service.Code", "%q is not a valid freight service", s.Service.Code))
+	}
+
+	switch s.PaymentInformation.ShipmentBillingOption.Code {
+	case FreightBillingPrepaid, FreightBillingThirdParty, FreightBillingFreightCollect:
+	default:
+		errs = append(errs, newValidationError("PaymentInformation.ShipmentBillingOption.Code", "%q is not valid, use 10, 30 or 40", s.PaymentInformation.ShipmentBillingOption.Code))
+	}
+
+	for i, unit := range []*FreightHandlingUnit{s.HandlingUnitOne, s.HandlingUnitTwo} {
+		if unit == nil {
+			continue
+		}
+
+		field := []string{"HandlingUnitOne", "HandlingUnitTwo"}[i]
+
+		if !slices.Contains(handlingUnitTypes, unit.Type.Code) {
+			errs = append(errs, newValidationError(field+".Type.Code", "%q is not a valid handling unit type", unit.Type.Code))
+		}
+
+		if err := validateDecimal(field+".Quantity", unit.Quantity, 0); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if len(s.Commodities) == 0 {
+		errs = append(errs, newValidationError("Commodity", "at least one commodity is required"))
+	}
+
+	for i, c := range s.Commodities {
+		field := fmt.Sprintf("Commodity[%d]", i)
+
+		if !IsValidFreightClass(c.FreightClass) {
+			errs = append(errs, newValidationError(field+".FreightClass", "%q is not a valid freight class", c.FreightClass))
+		}
+
+		switch c.Weight.UnitOfMeasurement.Code {
+		case "LBS", "KGS":
+		default:
+			errs = append(errs, newValidationError(field+".Weight.UnitOfMeasurement.Code", "%q is not valid, use LBS or KGS", c.Weight.UnitOfMeasurement.Code))
+		}
+
+		if err := validateDecimal(field+".Weight.Value", c.Weight.Value, 1); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	if o := s.ShipmentServiceOptions; o != nil {
+		if o.PickupOptions != nil && o.PickupOptions.ResidentialPickupIndicator != "" && s.ShipFrom.Address.ResidentialAddressIndicator == "" {
+			errs = append(errs, newValidationError("ShipmentServiceOptions.PickupOptions.ResidentialPickupIndicator", "requires a residential ShipFrom address"))
+		}
+
+		if o.DeliveryOptions != nil && o.DeliveryOptions.ResidentialDeliveryIndicator != "" && s.ShipTo.Address.ResidentialAddressIndicator == "" {
+			errs = append(errs, newValidationError("ShipmentServiceOptions.DeliveryOptions.ResidentialDeliveryIndicator", "requires a residential ShipTo address"))
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+type FreightRateRequest struct {
+	// Request container.
+	Request *FreightRequest `json:",omitempty"`
+	FreightShipment
+}
+
+type FreightRateResponse struct {
+	// Response container.
+	Response Response
+	// Itemized rates of the shipment.
+	Rates []FreightRate `json:"Rate"`
+	// Total charge of the shipment.
+	TotalShipmentCharge Charge
+	// Billable weight of the shipment.
+	BillableShipmentWeight FreightWeightValue
+	// Freight service.
+	Service FreightService
+	// Indicates that the service is guaranteed.
+	GuaranteedIndicator string
+	// Time in transit container.
+	TimeInTransit *FreightTimeInTransit
+}
+
+func (r *FreightRateResponse) UnmarshalJSON(data []byte) error {
+	type alias FreightRateResponse
+
+	var v struct {
+		alias
+		Rate json.RawMessage
+	}
+
+	err := json.Unmarshal(data, &v)
+	if err != nil {
+		return err
+	}
+
+	*r = FreightRateResponse(v.alias)
+
+	if len(v.Rate) > 0 {
+		return unmarshalOneOrMany(v.Rate, &r.Rates)
+	}
+
+	return nil
+}
+
+type FreightRate struct {
+	// Rate type, e.g. LND_GROSS, DSCNT, AFTR_DSCNT, or an accessorial.
+	Type FreightRateType
+	// Value of the rate.
+	Factor FreightWeightValue
+}
+
+type FreightRateType struct {
+	Code        string
+	Description string
+}
+
+type FreightWeightValue struct {
+	Value             string
+	UnitOfMeasurement FreightWeightUnitOfMeasurement
+}
+
+type FreightTimeInTransit struct {
+	// Number of business days in transit.
+	DaysInTransit string
+}
+
+// RateFreight requests a rate quote for an LTL freight shipment.
+func (c *Client) RateFreight(ctx context.Context, request FreightRateRequest) (*FreightRateResponse, error) {
+	var response struct {
+		FreightRateResponse *FreightRateResponse
+	}
+
+	err := c.do(ctx, http.MethodPost, freightURL+"/rating/ground", struct {
+		FreightRateRequest FreightRateRequest
+	}{
+		FreightRateRequest: request,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.FreightRateResponse, nil
+}
+
+// FreightShipRequest is the request of CreateFreightShipment. It does not
+// match the JSON sent to UPS: Documents and PickupRequest are part of the
+// shipment container on the wire, so CreateFreightShipment moves them into
+// Shipment next to the fields of FreightShipment:
+//
+//	{"FreightShipRequest": {
+//		"Request": {...},
+//		"Shipment": {"ShipFrom": {...}, ..., "Documents": {...}, "PickupRequest": {...}}
+//	}}
+//
+// Marshaling a FreightShipRequest directly does not produce a valid request.
+type FreightShipRequest struct {
+	// Request container.
+	Request *FreightRequest `json:",omitempty"`
+	// Shipment container.
+	Shipment FreightShipment
+	// Documents to be returned, e.g. the bill of lading. Sent as
+	// Shipment.Documents.
+	Documents *FreightDocuments `json:",omitempty"`
+	// Schedules a pickup with the shipment. Sent as Shipment.PickupRequest.
+	PickupRequest *FreightShipPickupRequest `json:",omitempty"`
+}
+
+type FreightDocuments struct {
+	Images []FreightDocumentImage `json:"Image"`
+}
+
+type FreightDocumentImage struct {
+	// Document type.
+	Type FreightDocumentType
+	// Number of labels per page.
+	LabelsPerPage string `json:",omitempty"`
+	// Format of the document. 01 = PDF.
+	Format FreightDocumentFormat
+	// Print format. 01 = Laser, 02 = Thermal.
+	PrintFormat FreightDocumentFormat
+	// Print size of the document.
+	PrintSize FreightPrintSize
+}
+
+type FreightDocumentType struct {
+	// Valid values: 20 = Bill of lading, 30 = Labels.
+	Code        string `validate:"len=2"`
+	Description string `json:",omitempty"`
+}
+
+type FreightDocumentFormat struct {
+	Code        string `validate:"len=2"`
+	Description string `json:",omitempty"`
+}
+
+type FreightPrintSize struct {
+	// Length in inches, the longer side of portrait paper, e.g. 11 for
+	// letter size.
+	Length string
+	// Width in inches, e.g. 8.5 for letter size.
+	Width string
+}
+
+type FreightShipPickupRequest struct {
+	// Pickup date in format YYYYMMDD.
+	PickupDate string `validate:"len=8"`
+	// Earliest time the freight is ready in format HHMM.
+	EarliestTimeReady string `validate:"len=4"`
+	// Latest time the freight is ready in format HHMM.
+	LatestTimeReady string `validate:"len=4"`
+	// The person requesting the pickup.
+	Requester *FreightParty `json:",omitempty"`
+	// Additional instructions for the driver.
+	PickupInstructions string `json:",omitempty" validate:"max=500"`
+}
+
+type FreightShipResponse struct {
+	// Response container.
+	Response Response
+	// Shipment results container.
+	ShipmentResults FreightShipmentResults
+}
+
+type FreightShipmentResults struct {
+	// The shipment (PRO) number.
+	ShipmentNumber string
+	// The bill of lading ID.
+	BOLID string
+	// Confirmation number if a pickup was requested.
+	PickupRequestConfirmationNumber string
+	// Total charge of the shipment.
+	TotalShipmentCharge *Charge
+	// Itemized rates of the shipment.
+	Rates []FreightRate `json:"Rate"`
+	// Requested documents.
+	Documents []FreightDocument
+}
+
+func (r *FreightShipmentResults) UnmarshalJSON(data []byte) error {
+	type alias FreightShipmentResults
+
+	var v struct {
+		alias
+		Rate      json.RawMessage
+		Documents *struct {
+			Image json.RawMessage
+		}
+	}
+
+	err := json.Unmarshal(data, &v)
+	if err != nil {
+		return err
+	}
+
+	*r = FreightShipmentResults(v.alias)
+
+	if len(v.Rate) > 0 {
+		err = unmarshalOneOrMany(v.Rate, &r.Rates)
+		if err != nil {
+			return err
+		}
+	}
+
+	if v.Documents != nil && len(v.Documents.Image) > 0 {
+		return unmarshalOneOrMany(v.Documents.Image, &r.Documents)
+	}
+
+	return nil
+}
+
+type FreightDocument struct {
+	// Document type.
+	Type FreightDocumentType
+	// Base64 encoded document.
+	GraphicImage string
+	// Format of the document.
+	Format FreightDocumentFormat
+}
+
+// Decode returns the decoded document.
+func (d *FreightDocument) Decode() ([]byte, error) {
+	if d.GraphicImage == "" {
+		return nil, ErrNoGraphicImage
+	}
+
+	return decodeBase64(d.GraphicImage)
+}
+
+// BOL returns the decoded bill of lading. ErrNoBOL is returned if it was not
+// requested with FreightShipRequest.Documents.
+func (r *FreightShipmentResults) BOL() ([]byte, error) {
+	return decodeBOL(r.Documents)
+}
+
+func decodeBOL(documents []FreightDocument) ([]byte, error) {
+	for i := range documents {
+		if documents[i].Type.Code == FreightDocumentBOL {
+			return documents[i].Decode()
+		}
+	}
+
+	return nil, ErrNoBOL
+}
+
+// CreateFreightShipment creates an LTL freight shipment. The bill of lading
+// is returned if requested with FreightShipRequest.Documents.
+func (c *Client) CreateFreightShipment(ctx context.Context, request FreightShipRequest) (*FreightShipResponse, error) {
+	// UPS expects the documents and the pickup request inside of the
+	// shipment container.
+	type shipment struct {
+		FreightShipment
+		Documents     *FreightDocuments         `json:",omitempty"`
+		PickupRequest *FreightShipPickupRequest `json:",omitempty"`
+	}
+
+	type shipRequest struct {
+		Request  *FreightRequest `json:",omitempty"`
+		Shipment shipment
+	}
+
+	var response struct {
+		FreightShipResponse *FreightShipResponse
+	}
+
+	err := c.do(ctx, http.MethodPost, freightURL+"/shipments/ground", struct {
+		FreightShipRequest shipRequest
+	}{
+		FreightShipRequest: shipRequest{
+			Request: request.Request,
+			Shipment: shipment{
+				FreightShipment: request.Shipment,
+				Documents:       request.Documents,
+				PickupRequest:   request.PickupRequest,
+			},
+		},
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.FreightShipResponse, nil
+}
+
+// BOLDocuments returns the document request for a PDF bill of lading printed
+// on letter size paper.
+func BOLDocuments() *FreightDocuments {
+	return &FreightDocuments{
+		Images: []FreightDocumentImage{
+			{
+				Type:        FreightDocumentType{Code: FreightDocumentBOL},
+				Format:      FreightDocumentFormat{Code: "01"},
+				PrintFormat: FreightDocumentFormat{Code: "01"},
+				PrintSize:   FreightPrintSize{Length: "11", Width: "8.5"},
+			},
+		},
+	}
+}
+
+type FreightDocumentRequest struct {
+	// Request container.
+	Request *FreightRequest `json:",omitempty"`
+	// The shipment (PRO) number of the shipment.
+	ShipmentNumber string `validate:"min=1,max=20"`
+	// The documents to return. Defaults to BOLDocuments.
+	Documents *FreightDocuments `json:",omitempty"`
+}
+
+type FreightDocumentResponse struct {
+	// Response container.
+	Response Response
+	// The shipment (PRO) number of the shipment.
+	ShipmentNumber string
+	// Requested documents.
+	Documents []FreightDocument
+}
+
+func (r *FreightDocumentResponse) UnmarshalJSON(data []byte) error {
+	type alias FreightDocumentResponse
+
+	var v struct {
+		alias
+		Documents *struct {
+			Image json.RawMessage
+		}
+	}
+
+	err := json.Unmarshal(data, &v)
+	if err != nil {
+		return err
+	}
+
+	*r = FreightDocumentResponse(v.alias)
+
+	if v.Documents != nil && len(v.Documents.Image) > 0 {
+		return unmarshalOneOrMany(v.Documents.Image, &r.Documents)
+	}
+
+	return nil
+}
+
+// BOL returns the decoded bill of lading. ErrNoBOL is returned if it is not
+// part of the documents.
+func (r *FreightDocumentResponse) BOL() ([]byte, error) {
+	return decodeBOL(r.Documents)
+}
+
+// RetrieveFreightDocuments returns the documents of an existing LTL freight
+// shipment, e.g. to reprint the bill of lading.
+func (c *Client) RetrieveFreightDocuments(ctx context.Context, request FreightDocumentRequest) (*FreightDocumentResponse, error) {
+	if request.ShipmentNumber == "" {
+		return nil, newValidationError("ShipmentNumber", "is required")
+	}
+
+	if request.Documents == nil {
+		request.Documents = BOLDocuments()
+	}
+
+	var response struct {
+		FreightDocumentResponse *FreightDocumentResponse
+	}
+
+	err := c.do(ctx, http.MethodPost, freightURL+"/shipments/ground/documents", struct {
+		FreightDocumentRequest FreightDocumentRequest
+	}{
+		FreightDocumentRequest: request,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.FreightDocumentResponse, nil
+}
+
+// RetrieveBOL returns the decoded PDF bill of lading of an existing LTL
+// freight shipment.
+func (c *Client) RetrieveBOL(ctx context.Context, shipmentNumber string) ([]byte, error) {
+	response, err := c.RetrieveFreightDocuments(ctx, FreightDocumentRequest{ShipmentNumber: shipmentNumber})
+	if err != nil {
+		return nil, err
+	}
+
+	return response.BOL()
+}
+
+type FreightPickupRequest struct {
+	// Request container.
+	Request *FreightRequest `json:",omitempty"`
+	// Postal code of the destination.
+	DestinationPostalCode string `json:",omitempty" validate:"max=9"`
+	// Country code of the destination.
+	DestinationCountryCode string `validate:"len=2"`
+	// The person requesting the pickup.
+	Requester FreightParty
+	// Location the freight is picked up.
+	ShipFrom FreightParty
+	// Pickup date in format YYYYMMDD.
+	PickupDate string `validate:"len=8"`
+	// Earliest time the freight is ready in format HHMM.
+	EarliestTimeReady string `validate:"len=4"`
+	// Latest time the freight is ready in format HHMM.
+	LatestTimeReady string `validate:"len=4"`
+	// Details of the freight.
+	ShipmentDetail *FreightPickupShipmentDetail `json:",omitempty"`
+	// Additional instructions for the driver.
+	PickupInstructions string `json:",omitempty" validate:"max=500"`
+}
+
+type FreightPickupShipmentDetail struct {
+	// Indicates that the freight contains hazardous materials.
+	HazmatIndicator string `json:",omitempty"`
+	// Packaging type of the freight.
+	PackagingType HandlingUnitType
+	// Number of pieces.
+	NumberOfPieces string `validate:"min=1,max=4"`
+	// Description of the freight.
+	DescriptionOfCommodity string `validate:"max=35"`
+	// Weight of the freight.
+	Weight FreightWeight
+}
+
+type FreightPickupResponse struct {
+	// Response container.
+	Response Response
+	// Confirmation number of the pickup.
+	PickupRequestConfirmationNumber string
+}
+
+// RequestFreightPickup schedules a pickup of LTL freight.
+func (c *Client) RequestFreightPickup(ctx context.Context, request FreightPickupRequest) (*FreightPickupResponse, error) {
+	if _, err := time.Parse("20060102", request.PickupDate); err != nil {
+		return nil, newValidationError("PickupDate", "%q is not in format YYYYMMDD", request.PickupDate)
+	}
+
+	var response struct {
+		FreightPickupResponse *FreightPickupResponse
+	}
+
+	err := c.do(ctx, http.MethodPost, freightURL+"/pickups", struct {
+		FreightPickupRequest FreightPickupRequest
+	}{
+		FreightPickupRequest: request,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.FreightPickupResponse, nil
+}
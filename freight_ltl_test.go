@@ -0,0 +1,234 @@
+package ups
+
+import (
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+	"testing"
+)
+
+var testBOL = []byte("%PDF-1.4 bill of lading")
+
+func testFreightShipment() FreightShipment {
+	return FreightShipment{
+		ShipFrom:      FreightParty{Name: "Shipper", Address: ShipToAddress{CountryCode: "US"}},
+		ShipperNumber: "A12345",
+		ShipTo:        FreightParty{Name: "Receiver", Address: ShipToAddress{CountryCode: "US"}},
+		PaymentInformation: FreightPaymentInformation{
+			ShipmentBillingOption: FreightShipmentBillingOption{Code: FreightBillingPrepaid},
+		},
+		Service:         FreightService{Code: FreightServiceLTL},
+		HandlingUnitOne: &FreightHandlingUnit{Quantity: "2", Type: HandlingUnitType{Code: "PLT"}},
+		Commodities: []FreightCommodity{{
+			Description:  "Machine parts",
+			Weight:       FreightWeight{UnitOfMeasurement: FreightWeightUnitOfMeasurement{Code: "LBS"}, Value: "750.5"},
+			FreightClass: "77.5",
+		}},
+	}
+}
+
+func TestFreightShipmentValidate(t *testing.T) {
+	tests := []struct {
+		name   string
+		modify func(s *FreightShipment)
+		want   []string
+	}{
+		{
+			name:   "valid",
+			modify: func(s *FreightShipment) {},
+		},
+		{
+			name: "invalid service and billing option",
+			modify: func(s *FreightShipment) {
+				s.Service.Code = "03"
+				s.PaymentInformation.ShipmentBillingOption.Code = "20"
+			},
+			want: []string{"Service.Code", "PaymentInformation.ShipmentBillingOption.Code"},
+		},
+		{
+			name: "invalid handling unit",
+			modify: func(s *FreightShipment) {
+				s.HandlingUnitTwo = &FreightHandlingUnit{Quantity: "1.5", Type: HandlingUnitType{Code: "BOX"}}
+			},
+			want: []string{"HandlingUnitTwo.Type.Code", "HandlingUnitTwo.Quantity"},
+		},
+		{
+			name:   "no commodities",
+			modify: func(s *FreightShipment) { s.Commodities = nil },
+			want:   []string{"Commodity"},
+		},
+		{
+			name: "invalid commodity",
+			modify: func(s *FreightShipment) {
+				s.Commodities[0].FreightClass = "80"
+				s.Commodities[0].Weight = FreightWeight{UnitOfMeasurement: FreightWeightUnitOfMeasurement{Code: "OZS"}, Value: "1.25"}
+			},
+			want: []string{"Commodity[0].FreightClass", "Commodity[0].Weight.UnitOfMeasurement.Code", "Commodity[0].Weight.Value"},
+		},
+		{
+			name: "residential accessorials",
+			modify: func(s *FreightShipment) {
+				s.ShipTo.Address.ResidentialAddressIndicator = " "
+				s.ShipmentServiceOptions = &FreightShipmentServiceOptions{
+					PickupOptions:   &FreightPickupOptions{ResidentialPickupIndicator: " "},
+					DeliveryOptions: &FreightDeliveryOptions{ResidentialDeliveryIndicator: " ", LiftGateRequiredIndicator: " "},
+				}
+			},
+			want: []string{"ShipmentServiceOptions.PickupOptions.ResidentialPickupIndicator"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := testFreightShipment()
+			tt.modify(&s)
+
+			checkValidationFields(t, s.Validate(), tt.want)
+		})
+	}
+}
+
+func TestBOLDocuments(t *testing.T) {
+	image := BOLDocuments().Images[0]
+
+	if image.Type.Code != FreightDocumentBOL || image.Format.Code != "01" {
+		t.Errorf("BOLDocuments() = %+v, want a PDF bill of lading", image)
+	}
+
+	if image.PrintSize.Length != "11" || image.PrintSize.Width != "8.5" {
+		t.Errorf("BOLDocuments() print size = %+v, want letter size 11 x 8.5", image.PrintSize)
+	}
+}
+
+func TestCreateFreightShipment(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != freightURL+"/shipments/ground" {
+			t.Errorf("request to %s", r.URL.Path)
+		}
+
+		var request struct {
+			FreightShipRequest struct {
+				Shipment map[string]json.RawMessage
+			}
+		}
+
+		if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+			t.Fatal(err)
+		}
+
+		for _, key := range []string{"ShipFrom", "Commodity", "Documents", "PickupRequest"} {
+			if _, ok := request.FreightShipRequest.Shipment[key]; !ok {
+				t.Errorf("Shipment has no %s", key)
+			}
+		}
+
+		w.Write([]byte(`{"FreightShipResponse":{"ShipmentResults":{"ShipmentNumber":"123456789","BOLID":"42","Rate":{"Type":{"Code":"DSCNT"}},"Documents":{"Image":{"Type":{"Code":"20"},"GraphicImage":"` + base64.StdEncoding.EncodeToString(testBOL) + `"}}}}}`))
+	})
+
+	response, err := c.CreateFreightShipment(context.Background(), FreightShipRequest{
+		Shipment:      testFreightShipment(),
+		Documents:     BOLDocuments(),
+		PickupRequest: &FreightShipPickupRequest{PickupDate: "20261020", EarliestTimeReady: "0800", LatestTimeReady: "1600"},
+	})
+	if err != nil {
+		t.Fatalf("CreateFreightShipment() error = %v", err)
+	}
+
+	results := response.ShipmentResults
+	if results.ShipmentNumber != "123456789" || len(results.Rates) != 1 {
+		t.Errorf("ShipmentResults = %+v", results)
+	}
+
+	bol, err := results.BOL()
+	if err != nil || string(bol) != string(testBOL) {
+		t.Errorf("BOL() = %q, %v, want %q", bol, err, testBOL)
+	}
+}
+
+func TestRetrieveBOL(t *testing.T) {
+	tests := []struct {
+		name     string
+		response string
+		want     []byte
+		wantErr  error
+	}{
+		{
+			name:     "bill of lading",
+			response: `{"FreightDocumentResponse":{"ShipmentNumber":"123456789","Documents":{"Image":[{"Type":{"Code":"30"},"GraphicImage":"bGFiZWw="},{"Type":{"Code":"20"},"GraphicImage":"` + base64.StdEncoding.EncodeToString(testBOL) + `"}]}}}`,
+			want:     testBOL,
+		},
+		{
+			name:     "no bill of lading",
+			response: `{"FreightDocumentResponse":{"ShipmentNumber":"123456789"}}`,
+			wantErr:  ErrNoBOL,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != freightURL+"/shipments/ground/documents" {
+					t.Errorf("request to %s", r.URL.Path)
+				}
+
+				body, _ := io.ReadAll(r.Body)
+
+				var request struct {
+					FreightDocumentRequest FreightDocumentRequest
+				}
+
+				if err := json.Unmarshal(body, &request); err != nil {
+					t.Fatal(err)
+				}
+
+				if got := request.FreightDocumentRequest; got.ShipmentNumber != "123456789" || got.Documents == nil || got.Documents.Images[0].Type.Code != FreightDocumentBOL {
+					t.Errorf("FreightDocumentRequest = %s", body)
+				}
+
+				w.Write([]byte(tt.response))
+			})
+
+			got, err := c.RetrieveBOL(context.Background(), "123456789")
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("RetrieveBOL() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if string(got) != string(tt.want) {
+				t.Errorf("RetrieveBOL() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRetrieveFreightDocumentsWithoutShipmentNumber(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		t.Error("unexpected request")
+	})
+
+	var validationErr *ValidationError
+	if _, err := c.RetrieveFreightDocuments(context.Background(), FreightDocumentRequest{}); !errors.As(err, &validationErr) {
+		t.Errorf("RetrieveFreightDocuments() error = %v, want ValidationError", err)
+	}
+}
+
+func TestRequestFreightPickup(t *testing.T) {
+	c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+		w.Write([]byte(`{"FreightPickupResponse":{"PickupRequestConfirmationNumber":"WBU1234"}}`))
+	})
+
+	if _, err := c.RequestFreightPickup(context.Background(), FreightPickupRequest{PickupDate: "2026-10-20"}); err == nil {
+		t.Error("RequestFreightPickup() with invalid date error = nil")
+	}
+
+	response, err := c.RequestFreightPickup(context.Background(), FreightPickupRequest{PickupDate: "20261020"})
+	if err != nil {
+		t.Fatalf("RequestFreightPickup() error = %v", err)
+	}
+
+	if response.PickupRequestConfirmationNumber != "WBU1234" {
+		t.Errorf("PickupRequestConfirmationNumber = %q, want WBU1234", response.PickupRequestConfirmationNumber)
+	}
+}
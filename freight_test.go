@@ -0,0 +1,188 @@
+package ups
+
+import (
+	"encoding/json"
+	"errors"
+	"slices"
+	"testing"
+)
+
+func TestValidateFreight(t *testing.T) {
+	inches := FreightUnitOfMeasurement{Code: "IN"}
+	pallet := HandlingUnit{
+		Quantity:   "2",
+		Type:       HandlingUnitType{Code: HandlingUnitTypePallet},
+		Dimensions: HandlingUnitDimensions{UnitOfMeasurement: inches, Length: "48", Width: "40", Height: "50"},
+	}
+	commodity := &Commodity{FreightClass: "77.5"}
+
+	tests := []struct {
+		name     string
+		payment  *FRSPaymentInformation
+		info     *FreightShipmentInformation
+		packages []Package
+		modify   func(s *Shipment)
+		want     []string
+	}{
+		{
+			name:     "prepaid",
+			payment:  &FRSPaymentInformation{Type: FRSPaymentInformationType{Code: FRSPaymentTypePrepaid}, AccountNumber: "A12345"},
+			packages: []Package{{Commodity: commodity}},
+		},
+		{
+			name:     "freight collect without address",
+			payment:  &FRSPaymentInformation{Type: FRSPaymentInformationType{Code: FRSPaymentTypeFreightCollect}},
+			packages: []Package{{Commodity: commodity}},
+			want:     []string{"Shipment.FRSPaymentInformation.Address"},
+		},
+		{
+			name:     "third party with address",
+			payment:  &FRSPaymentInformation{Type: FRSPaymentInformationType{Code: FRSPaymentTypeThirdParty}, Address: &FRSPaymentInformationAddress{CountryCode: "US"}},
+			packages: []Package{{Commodity: commodity}},
+		},
+		{
+			name:     "invalid payment type and missing commodity",
+			payment:  &FRSPaymentInformation{Type: FRSPaymentInformationType{Code: "04"}, Address: &FRSPaymentInformationAddress{CountryCode: "US"}},
+			packages: []Package{{Commodity: commodity}, {}},
+			want:     []string{"Shipment.FRSPaymentInformation.Type.Code", "Shipment.Package[1].Commodity"},
+		},
+		{
+			name:     "with PaymentInformation",
+			payment:  &FRSPaymentInformation{Type: FRSPaymentInformationType{Code: FRSPaymentTypePrepaid}},
+			packages: []Package{{Commodity: commodity}},
+			modify:   func(s *Shipment) { s.PaymentInformation = &PaymentInformation{} },
+			want:     []string{"Shipment.PaymentInformation"},
+		},
+		{
+			name: "density information without Ground Freight Pricing",
+			info: &FreightShipmentInformation{FreightDensityInfo: &FreightDensityInfo{HandlingUnits: []HandlingUnit{pallet}}},
+			want: []string{"Shipment.FreightShipmentInformation"},
+		},
+		{
+			name:     "density based rating",
+			payment:  &FRSPaymentInformation{Type: FRSPaymentInformationType{Code: FRSPaymentTypePrepaid}},
+			packages: []Package{{Commodity: commodity}},
+			info: &FreightShipmentInformation{
+				DensityEligibleIndicator: " ",
+				FreightDensityInfo: &FreightDensityInfo{
+					AdjustedHeightIndicator: " ",
+					AdjustedHeight:          &AdjustedHeight{Value: "60", UnitOfMeasurement: inches},
+					HandlingUnits:           []HandlingUnit{pallet},
+				},
+			},
+		},
+		{
+			name:     "density based rating without density information",
+			payment:  &FRSPaymentInformation{Type: FRSPaymentInformationType{Code: FRSPaymentTypePrepaid}},
+			packages: []Package{{Commodity: commodity}},
+			info:     &FreightShipmentInformation{DensityEligibleIndicator: " "},
+			want:     []string{"Shipment.FreightShipmentInformation.FreightDensityInfo"},
+		},
+		{
+			name:     "adjusted height without indicator",
+			payment:  &FRSPaymentInformation{Type: FRSPaymentInformationType{Code: FRSPaymentTypePrepaid}},
+			packages: []Package{{Commodity: commodity}},
+			info: &FreightShipmentInformation{FreightDensityInfo: &FreightDensityInfo{
+				AdjustedHeight: &AdjustedHeight{Value: "60", UnitOfMeasurement: inches},
+				HandlingUnits:  []HandlingUnit{pallet},
+			}},
+			want: []string{"Shipment.FreightShipmentInformation.FreightDensityInfo.AdjustedHeight"},
+		},
+		{
+			name:     "invalid adjusted height",
+			payment:  &FRSPaymentInformation{Type: FRSPaymentInformationType{Code: FRSPaymentTypePrepaid}},
+			packages: []Package{{Commodity: commodity}},
+			info: &FreightShipmentInformation{FreightDensityInfo: &FreightDensityInfo{
+				AdjustedHeightIndicator: " ",
+				AdjustedHeight:          &AdjustedHeight{Value: "60.5", UnitOfMeasurement: FreightUnitOfMeasurement{Code: "CM"}},
+				HandlingUnits:           []HandlingUnit{pallet},
+			}},
+			want: []string{
+				"Shipment.FreightShipmentInformation.FreightDensityInfo.AdjustedHeight.UnitOfMeasurement.Code",
+				"Shipment.FreightShipmentInformation.FreightDensityInfo.AdjustedHeight.Value",
+			},
+		},
+		{
+			name:     "invalid handling units",
+			payment:  &FRSPaymentInformation{Type: FRSPaymentInformationType{Code: FRSPaymentTypePrepaid}},
+			packages: []Package{{Commodity: commodity}},
+			info: &FreightShipmentInformation{FreightDensityInfo: &FreightDensityInfo{
+				AdjustedHeightIndicator: " ",
+				HandlingUnits: []HandlingUnit{pallet, {
+					Quantity:   "1.5",
+					Type:       HandlingUnitType{Code: "BOX"},
+					Dimensions: HandlingUnitDimensions{UnitOfMeasurement: FreightUnitOfMeasurement{Code: "CM"}},
+				}},
+			}},
+			want: []string{
+				"Shipment.FreightShipmentInformation.FreightDensityInfo.AdjustedHeight",
+				"Shipment.FreightShipmentInformation.FreightDensityInfo.HandlingUnits[1].Type.Code",
+				"Shipment.FreightShipmentInformation.FreightDensityInfo.HandlingUnits[1].Quantity",
+				"Shipment.FreightShipmentInformation.FreightDensityInfo.HandlingUnits[1].Dimensions.UnitOfMeasurement.Code",
+			},
+		},
+		{
+			name:     "no handling units",
+			payment:  &FRSPaymentInformation{Type: FRSPaymentInformationType{Code: FRSPaymentTypePrepaid}},
+			packages: []Package{{Commodity: commodity}},
+			info:     &FreightShipmentInformation{FreightDensityInfo: &FreightDensityInfo{}},
+			want:     []string{"Shipment.FreightShipmentInformation.FreightDensityInfo.HandlingUnits"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := testShipment("US", "US")
+			s.FRSPaymentInformation = tt.payment
+			s.FreightShipmentInformation = tt.info
+			s.Packages = tt.packages
+
+			if tt.modify != nil {
+				tt.modify(&s)
+			}
+
+			checkValidationFields(t, errors.Join(s.validateFreight()...), tt.want)
+		})
+	}
+}
+
+func TestFRSShipmentDataJSON(t *testing.T) {
+	tests := []struct {
+		name          string
+		handlingUnits string
+		want          []string
+	}{
+		{"single handling unit", `{"Quantity":"1","Type":{"Code":"PLT"}}`, []string{"PLT"}},
+		{"several handling units", `[{"Quantity":"1","Type":{"Code":"PLT"}},{"Quantity":"2","Type":{"Code":"SKD"}}]`, []string{"PLT", "SKD"}},
+		{"no handling units", "", nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := `{"RatingMethod":"01","FRSShipmentData":{"TransportationCharges":{"GrossCharge":{"CurrencyCode":"USD","MonetaryValue":"120.00"},"DiscountPercentage":"10","NetCharge":{"CurrencyCode":"USD","MonetaryValue":"108.00"}},"FreightDensityRate":{"Density":"9.5","TotalCubicFeet":"66.7"}`
+			if tt.handlingUnits != "" {
+				data += `,"HandlingUnits":` + tt.handlingUnits
+			}
+			data += `}}`
+
+			var results ShipmentResults
+			if err := json.Unmarshal([]byte(data), &results); err != nil {
+				t.Fatal(err)
+			}
+
+			frs := results.FRSShipmentData
+			if results.RatingMethod != "01" || frs == nil || frs.TransportationCharges.NetCharge.MonetaryValue != "108.00" || frs.FreightDensityRate.Density != "9.5" {
+				t.Fatalf("ShipmentResults = %+v", results)
+			}
+
+			var codes []string
+			for _, unit := range frs.HandlingUnits {
+				codes = append(codes, unit.Type.Code)
+			}
+
+			if !slices.Equal(codes, tt.want) {
+				t.Errorf("HandlingUnits = %v, want %v", codes, tt.want)
+			}
+		})
+	}
+}
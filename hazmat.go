@@ -0,0 +1,216 @@
+package ups
+
+import (
+	"fmt"
+	"slices"
+	"time"
+)
+
+// Regulation sets of HazMat.RegulationSet.
+const (
+	RegulationSetADR  = "ADR"
+	RegulationSetCFR  = "CFR"
+	RegulationSetIATA = "IATA"
+	RegulationSetTDG  = "TDG"
+)
+
+// Transportation modes of HazMat.TransportationMode.
+const (
+	TransportationModeGround            = "GND"
+	TransportationModeCargoAircraftOnly = "CAO"
+	TransportationModePassengerAircraft = "PAX"
+)
+
+// Commodity regulated levels of HazMat.CommodityRegulatedLevelCode.
+const (
+	CommodityRegulatedLevelFullyRegulated   = "FR"
+	CommodityRegulatedLevelLimitedQuantity  = "LQ"
+	CommodityRegulatedLevelExceptedQuantity = "EQ"
+	CommodityRegulatedLevelLightlyRegulated = "LR"
+)
+
+// groundServices contains the services which move by ground only.
+var groundServices = []ServiceCode{ServiceGround, ServiceStandard}
+
+// europeanCountries contains the countries or territories where ADR applies.
+var europeanCountries = append([]string{
+	"AD", "AL", "BA", "BY", "CH", "GB", "IS", "LI", "MC", "MD", "ME", "MK", "NO", "RS",
+	"SM", "TR", "UA",
+}, euCountries...)
+
+// lithiumBatteryIDNumbers contains the UN numbers of lithium batteries, which
+// are class 9.
+var lithiumBatteryIDNumbers = []string{"UN3090", "UN3091", "UN3480", "UN3481"}
+
+// isAirTransportationMode reports whether mode is one of the aircraft modes.
+func isAirTransportationMode(mode string) bool {
+	switch mode {
+	case TransportationModeCargoAircraftOnly, TransportationModePassengerAircraft, "Cargo Aircraft Only", "Passenger Aircraft":
+		return true
+	}
+
+	return false
+}
+
+// HasHazMat reports whether any package contains dangerous goods.
+func (s *Shipment) HasHazMat() bool {
+	for _, p := range s.Packages {
+		if p.PackageServiceOptions != nil && len(p.PackageServiceOptions.HazMats) > 0 {
+			return true
+		}
+	}
+
+	return false
+}
+
+func (s *Shipment) validateHazMat() []error {
+	var errs []error
+
+	origin, destination := s.originCountryCode(), s.destinationCountryCode()
+	regulationSets := make(map[string]bool)
+
+	for i, p := range s.Packages {
+		if p.PackageServiceOptions == nil {
+			if p.HazMatPackageInformation != nil {
+				errs = append(errs, newValidationError(fmt.Sprintf("Shipment.Package[%d].HazMatPackageInformation", i), "requires HazMat in PackageServiceOptions"))
+			}
+
+			continue
+		}
+
+		hazMats := p.PackageServiceOptions.HazMats
+		if len(hazMats) > 3 {
+			errs = append(errs, newValidationError(fmt.Sprintf("Shipment.Package[%d].PackageServiceOptions.HazMat", i), "up to 3 chemical records are allowed, got %d", len(hazMats)))
+		}
+
+		for j := range hazMats {
+			field := fmt.Sprintf("Shipment.Package[%d].PackageServiceOptions.HazMat[%d]", i, j)
+			errs = append(errs, hazMats[j].validate(field, s.Service.Code, origin, destination)...)
+			regulationSets[hazMats[j].RegulationSet] = true
+		}
+
+		if info := p.HazMatPackageInformation; info != nil {
+			field := fmt.Sprintf("Shipment.Package[%d].HazMatPackageInformation", i)
+
+			if len(hazMats) == 0 {
+				errs = append(errs, newValidationError(field, "requires HazMat in PackageServiceOptions"))
+			}
+
+			if info.QValue != "" {
+				if !slices.Contains([]string{"0.1", "0.2", "0.3", "0.4", "0.5", "0.6", "0.7", "0.8", "0.9", "1.0"}, info.QValue) {
+					errs = append(errs, newValidationError(field+".QValue", "%q is not valid", info.QValue))
+				}
+
+				if info.AllPackedInOneIndicator == "" {
+					errs = append(errs, newValidationError(field+".QValue", "requires AllPackedInOneIndicator"))
+				}
+			} else if info.AllPackedInOneIndicator != "" && slices.ContainsFunc(hazMats, func(h HazMat) bool { return h.RegulationSet == RegulationSetIATA }) {
+				errs = append(errs, newValidationError(field+".QValue", "is required for all packed in one IATA packages"))
+			}
+		}
+	}
+
+	if len(regulationSets) > 1 {
+		errs = append(errs, newValidationError("Shipment.Package", "the regulation set must be the same across the shipment"))
+	}
+
+	if info := s.DGSignatoryInfo; info != nil {
+		if info.Name == "" || info.Title == "" || info.Place == "" {
+			errs = append(errs, newValidationError("Shipment.DGSignatoryInfo", "name, title and place are required"))
+		}
+
+		if _, err := time.Parse("20060102", info.Date); err != nil {
+			errs = append(errs, newValidationError("Shipment.DGSignatoryInfo.Date", "%q is not in format YYYYMMDD", info.Date))
+		}
+
+		if info.ShipperDeclaration != "" && info.ShipperDeclaration != "01" && info.ShipperDeclaration != "02" {
+			errs = append(errs, newValidationError("Shipment.DGSignatoryInfo.ShipperDeclaration", "%q is not valid", info.ShipperDeclaration))
+		}
+
+		if !s.HasHazMat() {
+			errs = append(errs, newValidationError("Shipment.DGSignatoryInfo", "requires a package with HazMat"))
+		}
+	}
+
+	return errs
+}
+
+func (h *HazMat) validate(field, service, origin, destination string) []error {
+	var errs []error
+
+	switch h.RegulationSet {
+	case RegulationSetIATA:
+		if !isAirTransportationMode(h.TransportationMode) {
+			errs = append(errs, newValidationError(field+".TransportationMode", "%q is not an air transportation mode required by IATA", h.TransportationMode))
+		}
+
+		if slices.Contains(groundServices, ServiceCode(service)) {
+			errs = append(errs, newValidationError(field+".RegulationSet", "IATA is not valid for ground service %s", service))
+		}
+	case RegulationSetADR:
+		if isAirTransportationMode(h.TransportationMode) {
+			errs = append(errs, newValidationError(field+".TransportationMode", "%q is not valid for ground regulation set ADR", h.TransportationMode))
+		}
+
+		if !slices.Contains(europeanCountries, origin) || !slices.Contains(europeanCountries, destination) {
+			errs = append(errs, newValidationError(field+".RegulationSet", "ADR is only valid within Europe"))
+		}
+
+		if h.ADRItemNumber == "" || (h.PackagingGroupType != "" && h.ADRPackingGroupLetter == "") {
+			errs = append(errs, newValidationError(field, "ADR item number and packing group letter are required for ADR"))
+		}
+	case RegulationSetTDG:
+		if isAirTransportationMode(h.TransportationMode) {
+			errs = append(errs, newValidationError(field+".TransportationMode", "%q is not valid for ground regulation set TDG", h.TransportationMode))
+		}
+
+		if origin != "CA" || (destination != "CA" && destination != "US") {
+			errs = append(errs, newValidationError(field+".RegulationSet", "TDG is only valid from CA to CA or US"))
+		}
+	case RegulationSetCFR:
+		if origin != "US" && origin != "PR" {
+			errs = append(errs, newValidationError(field+".RegulationSet", "CFR is only valid from US/PR"))
+		}
+	default:
+		errs = append(errs, newValidationError(field+".RegulationSet", "%q is not valid, use ADR, CFR, IATA or TDG", h.RegulationSet))
+	}
+
+	switch h.CommodityRegulatedLevelCode {
+	case CommodityRegulatedLevelFullyRegulated, CommodityRegulatedLevelLimitedQuantity:
+		if h.ClassDivisionNumber == "" || h.PackagingTypeQuantity == "" {
+			errs = append(errs, newValidationError(field, "class division number and packaging type quantity are required for %s", h.CommodityRegulatedLevelCode))
+		}
+	case CommodityRegulatedLevelExceptedQuantity, CommodityRegulatedLevelLightlyRegulated:
+		if h.HazardLabelRequired != "" {
+			errs = append(errs, newValidationError(field+".HazardLabelRequired", "not applicable for %s", h.CommodityRegulatedLevelCode))
+		}
+	default:
+		errs = append(errs, newValidationError(field+".CommodityRegulatedLevelCode", "%q is not valid, use FR, LQ, EQ or LR", h.CommodityRegulatedLevelCode))
+	}
+
+	if h.IDNumber == "" || h.ProperShippingName == "" {
+		errs = append(errs, newValidationError(field, "ID number and proper shipping name are required"))
+	}
+
+	if slices.Contains(lithiumBatteryIDNumbers, h.IDNumber) && h.ClassDivisionNumber != "" && h.ClassDivisionNumber != "9" {
+		errs = append(errs, newValidationError(field+".ClassDivisionNumber", "lithium batteries %s are class 9", h.IDNumber))
+	}
+
+	switch h.PackagingGroupType {
+	case "", "I", "II", "III":
+	default:
+		errs = append(errs, newValidationError(field+".PackagingGroupType", "%q is not valid, use I, II or III", h.PackagingGroupType))
+	}
+
+	if h.Quantity != "" {
+		if err := validateDecimal(field+".Quantity", h.Quantity, 6); err != nil {
+			errs = append(errs, err)
+		}
+
+		if h.UOM == "" {
+			errs = append(errs, newValidationError(field+".UOM", "is required if a quantity is set"))
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,218 @@
+package ups
+
+import (
+	"errors"
+	"testing"
+)
+
+var testHazMat = HazMat{
+	RegulationSet:               RegulationSetADR,
+	TransportationMode:          TransportationModeGround,
+	CommodityRegulatedLevelCode: CommodityRegulatedLevelLimitedQuantity,
+	ClassDivisionNumber:         "9",
+	PackagingTypeQuantity:       "1",
+	IDNumber:                    "UN3481",
+	ProperShippingName:          "Lithium ion batteries packed with equipment",
+	ADRItemNumber:               "9",
+	PackagingGroupType:          "II",
+	ADRPackingGroupLetter:       "B",
+}
+
+func TestHazMatValidate(t *testing.T) {
+	tests := []struct {
+		name                string
+		origin, destination string
+		service             ServiceCode
+		modify              func(h *HazMat)
+		want                []string
+	}{
+		{
+			name:   "ADR",
+			modify: func(h *HazMat) {},
+		},
+		{
+			name:   "ADR by air",
+			modify: func(h *HazMat) { h.TransportationMode = TransportationModeCargoAircraftOnly },
+			want:   []string{"HazMat.TransportationMode"},
+		},
+		{
+			name:        "ADR outside Europe",
+			destination: "US",
+			modify:      func(h *HazMat) {},
+			want:        []string{"HazMat.RegulationSet"},
+		},
+		{
+			name:   "ADR without packing group letter",
+			modify: func(h *HazMat) { h.ADRPackingGroupLetter = "" },
+			want:   []string{"HazMat"},
+		},
+		{
+			name:   "IATA",
+			modify: func(h *HazMat) { h.RegulationSet, h.TransportationMode = RegulationSetIATA, "Passenger Aircraft" },
+		},
+		{
+			name:    "IATA by ground",
+			service: ServiceStandard,
+			modify:  func(h *HazMat) { h.RegulationSet = RegulationSetIATA },
+			want:    []string{"HazMat.TransportationMode", "HazMat.RegulationSet"},
+		},
+		{
+			name:        "TDG from CA to US",
+			origin:      "CA",
+			destination: "US",
+			modify:      func(h *HazMat) { h.RegulationSet = RegulationSetTDG },
+		},
+		{
+			name:   "TDG in Europe",
+			modify: func(h *HazMat) { h.RegulationSet = RegulationSetTDG },
+			want:   []string{"HazMat.RegulationSet"},
+		},
+		{
+			name:        "CFR from PR",
+			origin:      "PR",
+			destination: "US",
+			modify:      func(h *HazMat) { h.RegulationSet = RegulationSetCFR },
+		},
+		{
+			name:   "CFR from DE",
+			modify: func(h *HazMat) { h.RegulationSet = RegulationSetCFR },
+			want:   []string{"HazMat.RegulationSet"},
+		},
+		{
+			name:   "unknown regulation set",
+			modify: func(h *HazMat) { h.RegulationSet = "DOT" },
+			want:   []string{"HazMat.RegulationSet"},
+		},
+		{
+			name: "fully regulated without class division",
+			modify: func(h *HazMat) {
+				h.CommodityRegulatedLevelCode, h.ClassDivisionNumber = CommodityRegulatedLevelFullyRegulated, ""
+			},
+			want: []string{"HazMat"},
+		},
+		{
+			name: "excepted quantity with hazard label",
+			modify: func(h *HazMat) {
+				h.CommodityRegulatedLevelCode, h.HazardLabelRequired = CommodityRegulatedLevelExceptedQuantity, "Class 9"
+			},
+			want: []string{"HazMat.HazardLabelRequired"},
+		},
+		{
+			name:   "unknown regulated level",
+			modify: func(h *HazMat) { h.CommodityRegulatedLevelCode = "XX" },
+			want:   []string{"HazMat.CommodityRegulatedLevelCode"},
+		},
+		{
+			name:   "without ID number",
+			modify: func(h *HazMat) { h.IDNumber = "" },
+			want:   []string{"HazMat"},
+		},
+		{
+			name:   "lithium battery class",
+			modify: func(h *HazMat) { h.ClassDivisionNumber = "3" },
+			want:   []string{"HazMat.ClassDivisionNumber"},
+		},
+		{
+			name:   "invalid packaging group",
+			modify: func(h *HazMat) { h.PackagingGroupType = "IV" },
+			want:   []string{"HazMat.PackagingGroupType"},
+		},
+		{
+			name:   "quantity",
+			modify: func(h *HazMat) { h.Quantity, h.UOM = "0.5", "kg" },
+		},
+		{
+			name:   "invalid quantity without unit",
+			modify: func(h *HazMat) { h.Quantity = "1.1234567" },
+			want:   []string{"HazMat.Quantity", "HazMat.UOM"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			origin, destination := "DE", "FR"
+			if tt.origin != "" {
+				origin = tt.origin
+			}
+
+			if tt.destination != "" {
+				destination = tt.destination
+			}
+
+			h := testHazMat
+			tt.modify(&h)
+
+			checkValidationFields(t, errors.Join(h.validate("HazMat", string(tt.service), origin, destination)...), tt.want)
+		})
+	}
+}
+
+func TestValidateHazMat(t *testing.T) {
+	iata := testHazMat
+	iata.RegulationSet, iata.TransportationMode = RegulationSetIATA, TransportationModePassengerAircraft
+
+	tests := []struct {
+		name      string
+		packages  []Package
+		signatory *DGSignatoryInfo
+		want      []string
+	}{
+		{
+			name:      "valid",
+			packages:  []Package{{PackageServiceOptions: &PackageServiceOptions{HazMats: []HazMat{testHazMat}}}},
+			signatory: &DGSignatoryInfo{Name: "Shipper", Title: "Manager", Place: "Berlin", Date: "20261019", ShipperDeclaration: "01"},
+		},
+		{
+			name:     "too many chemical records",
+			packages: []Package{{PackageServiceOptions: &PackageServiceOptions{HazMats: []HazMat{testHazMat, testHazMat, testHazMat, testHazMat}}}},
+			want:     []string{"Shipment.Package[0].PackageServiceOptions.HazMat"},
+		},
+		{
+			name:     "package information without HazMat",
+			packages: []Package{{HazMatPackageInformation: &HazMatPackageInformation{OverPackedIndicator: " "}}},
+			want:     []string{"Shipment.Package[0].HazMatPackageInformation"},
+		},
+		{
+			name: "invalid Q value",
+			packages: []Package{{
+				PackageServiceOptions:    &PackageServiceOptions{HazMats: []HazMat{testHazMat}},
+				HazMatPackageInformation: &HazMatPackageInformation{QValue: "0.35"},
+			}},
+			want: []string{"Shipment.Package[0].HazMatPackageInformation.QValue", "Shipment.Package[0].HazMatPackageInformation.QValue"},
+		},
+		{
+			name: "all packed in one IATA package without Q value",
+			packages: []Package{{
+				PackageServiceOptions:    &PackageServiceOptions{HazMats: []HazMat{iata}},
+				HazMatPackageInformation: &HazMatPackageInformation{AllPackedInOneIndicator: " "},
+			}},
+			want: []string{"Shipment.Package[0].HazMatPackageInformation.QValue"},
+		},
+		{
+			name:     "different regulation sets",
+			packages: []Package{{PackageServiceOptions: &PackageServiceOptions{HazMats: []HazMat{testHazMat}}}, {PackageServiceOptions: &PackageServiceOptions{HazMats: []HazMat{iata}}}},
+			want:     []string{"Shipment.Package"},
+		},
+		{
+			name:      "invalid signatory",
+			packages:  []Package{{}},
+			signatory: &DGSignatoryInfo{Title: "Manager", Place: "Berlin", Date: "2026-10-19", ShipperDeclaration: "03"},
+			want: []string{
+				"Shipment.DGSignatoryInfo",
+				"Shipment.DGSignatoryInfo.Date",
+				"Shipment.DGSignatoryInfo.ShipperDeclaration",
+				"Shipment.DGSignatoryInfo",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := testShipment("DE", "FR")
+			s.Packages = tt.packages
+			s.DGSignatoryInfo = tt.signatory
+
+			checkValidationFields(t, errors.Join(s.validateHazMat()...), tt.want)
+		})
+	}
+}
@@ -0,0 +1,397 @@
+package ups
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"io"
+	"os"
+	"path/filepath"
+	"strings"
+)
+
+// LabelFormat is the image format of a label returned by UPS.
+type LabelFormat string
+
+const (
+	LabelFormatUnknown LabelFormat = ""
+	LabelFormatGIF     LabelFormat = "GIF"
+	LabelFormatPNG     LabelFormat = "PNG"
+	LabelFormatZPL     LabelFormat = "ZPL"
+	LabelFormatEPL     LabelFormat = "EPL"
+	LabelFormatSPL     LabelFormat = "SPL"
+	LabelFormatStarPL  LabelFormat = "STARPL"
+	LabelFormatHTML    LabelFormat = "HTML"
+	LabelFormatPDF     LabelFormat = "PDF"
+)
+
+// ErrNoGraphicImage is returned if a label does not contain any image data.
+var ErrNoGraphicImage = errors.New("label contains no graphic image")
+
+// ErrRasterParts is returned if a GIF or PNG label consists of GraphicImage
+// and GraphicImagePart, which are complete images each and can't be joined
+// into one file. Use ShippingLabel.DecodeParts or ShippingLabel.WriteFiles.
+var ErrRasterParts = errors.New("raster label has multiple parts")
+
+// ErrNoHTMLImage is returned if a label does not contain the HTML wrapper.
+// UPS only returns it for GIF labels.
+var ErrNoHTMLImage = errors.New("label contains no html image")
+
+// ParseLabelFormat returns the LabelFormat for an ImageFormat code. Unknown
+// codes result in LabelFormatUnknown.
+func ParseLabelFormat(code string) LabelFormat {
+	switch strings.ToUpper(strings.TrimSpace(code)) {
+	case "GIF":
+		return LabelFormatGIF
+	case "PNG":
+		return LabelFormatPNG
+	case "ZPL":
+		return LabelFormatZPL
+	case "EPL", "EPL2":
+		return LabelFormatEPL
+	case "SPL":
+		return LabelFormatSPL
+	case "STARPL":
+		return LabelFormatStarPL
+	case "HTML":
+		return LabelFormatHTML
+	case "PDF":
+		return LabelFormatPDF
+	}
+
+	return LabelFormatUnknown
+}
+
+// DetectLabelFormat guesses the LabelFormat from decoded label data. Raster
+// images are detected by their signature, printer languages by their leading
+// commands.
+func DetectLabelFormat(data []byte) LabelFormat {
+	trimmed := bytes.TrimLeft(data, " \t\r\n")
+
+	switch {
+	case bytes.HasPrefix(data, []byte("GIF87a")), bytes.HasPrefix(data, []byte("GIF89a")):
+		return LabelFormatGIF
+	case bytes.HasPrefix(data, []byte("\x89PNG\r\n\x1a\n")):
+		return LabelFormatPNG
+	case bytes.HasPrefix(trimmed, []byte("^XA")), bytes.HasPrefix(trimmed, []byte("~")):
+		return LabelFormatZPL
+	case bytes.HasPrefix(trimmed, []byte("N\n")), bytes.HasPrefix(trimmed, []byte("N\r\n")):
+		return LabelFormatEPL
+	case hasPrefixFold(trimmed, "<!doctype html"), hasPrefixFold(trimmed, "<html"):
+		return LabelFormatHTML
+	case bytes.HasPrefix(data, []byte("%PDF-")):
+		return LabelFormatPDF
+	}
+
+	return LabelFormatUnknown
+}
+
+func hasPrefixFold(data []byte, prefix string) bool {
+	return len(data) >= len(prefix) && strings.EqualFold(string(data[:len(prefix)]), prefix)
+}
+
+// Extension returns the file extension including the leading dot.
+func (f LabelFormat) Extension() string {
+	switch f {
+	case LabelFormatGIF:
+		return ".gif"
+	case LabelFormatPNG:
+		return ".png"
+	case LabelFormatZPL:
+		return ".zpl"
+	case LabelFormatEPL:
+		return ".epl"
+	case LabelFormatSPL:
+		return ".spl"
+	case LabelFormatStarPL:
+		return ".prn"
+	case LabelFormatHTML:
+		return ".html"
+	case LabelFormatPDF:
+		return ".pdf"
+	}
+
+	return ".bin"
+}
+
+// IsRaster reports whether the format is an image rather than a printer
+// language.
+func (f LabelFormat) IsRaster() bool {
+	return f == LabelFormatGIF || f == LabelFormatPNG
+}
+
+// Format returns the format of the label. ImageFormat.Code is used if it is
+// known, otherwise the format is detected from the decoded image.
+func (l *ShippingLabel) Format() LabelFormat {
+	if format := ParseLabelFormat(l.ImageFormat.Code); format != LabelFormatUnknown {
+		return format
+	}
+
+	data, err := decodeBase64(l.GraphicImage)
+	if err != nil {
+		return LabelFormatUnknown
+	}
+
+	return DetectLabelFormat(data)
+}
+
+// DecodeParts returns GraphicImage and, if present, GraphicImagePart as
+// separate decoded images. Raster parts are complete images each and have to
+// be handled one by one.
+func (l *ShippingLabel) DecodeParts() ([][]byte, error) {
+	if l.GraphicImage == "" {
+		return nil, ErrNoGraphicImage
+	}
+
+	image, err := decodeBase64(l.GraphicImage)
+	if err != nil {
+		return nil, err
+	}
+
+	parts := [][]byte{image}
+
+	if l.GraphicImagePart != "" {
+		part, err := decodeBase64(l.GraphicImagePart)
+		if err != nil {
+			return nil, err
+		}
+
+		parts = append(parts, part)
+	}
+
+	return parts, nil
+}
+
+// Decode returns the decoded label. GraphicImagePart is appended to
+// GraphicImage if present, which results in a single printable document for
+// ZPL, EPL and SPL labels. ErrRasterParts is returned for GIF and PNG labels
+// with GraphicImagePart.
+func (l *ShippingLabel) Decode() ([]byte, error) {
+	parts, err := l.DecodeParts()
+	if err != nil {
+		return nil, err
+	}
+
+	if len(parts) > 1 && l.Format().IsRaster() {
+		return nil, ErrRasterParts
+	}
+
+	return bytes.Join(parts, nil), nil
+}
+
+// DecodeHTML returns the decoded HTML wrapper which UPS returns for GIF
+// labels. It references the image as "./label<TrackingNumber>.gif".
+func (l *ShippingLabel) DecodeHTML() ([]byte, error) {
+	if l.HTMLImage == "" {
+		return nil, ErrNoHTMLImage
+	}
+
+	return decodeBase64(l.HTMLImage)
+}
+
+// WriteTo writes the decoded label into w. ErrRasterParts is returned for GIF
+// and PNG labels with GraphicImagePart.
+func (l *ShippingLabel) WriteTo(w io.Writer) (int64, error) {
+	data, err := l.Decode()
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := w.Write(data)
+	return int64(n), err
+}
+
+// WriteFile writes the decoded label into a file. The extension matching the
+// label format is appended to name if it is missing. The path of the written
+// file is returned. ErrRasterParts is returned for GIF and PNG labels with
+// GraphicImagePart, use WriteFiles for them.
+func (l *ShippingLabel) WriteFile(name string) (string, error) {
+	data, err := l.Decode()
+	if err != nil {
+		return "", err
+	}
+
+	return writeFileWithExtension(name, l.Format().Extension(), data)
+}
+
+// WriteFiles writes the decoded label like WriteFile. GIF and PNG labels with
+// GraphicImagePart are written as two images, the part with the suffix
+// "_part". The paths of the written files are returned.
+func (l *ShippingLabel) WriteFiles(name string) ([]string, error) {
+	if !l.Format().IsRaster() {
+		path, err := l.WriteFile(name)
+		if err != nil {
+			return nil, err
+		}
+
+		return []string{path}, nil
+	}
+
+	parts, err := l.DecodeParts()
+	if err != nil {
+		return nil, err
+	}
+
+	extension := l.Format().Extension()
+	if strings.EqualFold(filepath.Ext(name), extension) {
+		name = strings.TrimSuffix(name, filepath.Ext(name))
+	}
+
+	var paths []string
+
+	for i, part := range parts {
+		partName := name
+		if i > 0 {
+			partName += "_part"
+		}
+
+		path, err := writeFileWithExtension(partName, extension, part)
+		if err != nil {
+			return paths, err
+		}
+
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
+
+// WriteHTMLFile writes the decoded HTML wrapper into a file. The ".html"
+// extension is appended to name if it is missing. The path of the written file
+// is returned.
+func (l *ShippingLabel) WriteHTMLFile(name string) (string, error) {
+	data, err := l.DecodeHTML()
+	if err != nil {
+		return "", err
+	}
+
+	return writeFileWithExtension(name, ".html", data)
+}
+
+// WriteLabelFiles writes the label into dir using the file names UPS uses in
+// the HTML wrapper: label<TrackingNumber>.<ext> and, for GIF labels,
+// <TrackingNumber>.html. A raster GraphicImagePart is written as
+// label<TrackingNumber>_part.<ext>. The paths of the written files are
+// returned.
+func (p *PackageResults) WriteLabelFiles(dir string) ([]string, error) {
+	if p.ShippingLabel == nil {
+		return nil, ErrNoGraphicImage
+	}
+
+	paths, err := p.ShippingLabel.WriteFiles(filepath.Join(dir, "label"+p.TrackingNumber))
+	if err != nil {
+		return paths, err
+	}
+
+	if p.ShippingLabel.HTMLImage != "" {
+		path, err := p.ShippingLabel.WriteHTMLFile(filepath.Join(dir, p.TrackingNumber))
+		if err != nil {
+			return paths, err
+		}
+
+		paths = append(paths, path)
+	}
+
+	return paths, nil
+}
+
+func decodeBase64(s string) ([]byte, error) {
+	return base64.StdEncoding.DecodeString(strings.Join(strings.Fields(s), ""))
+}
+
+func writeFileWithExtension(name, extension string, data []byte) (string, error) {
+	if !strings.EqualFold(filepath.Ext(name), extension) {
+		name += extension
+	}
+
+	err := os.WriteFile(name, data, 0o644)
+	if err != nil {
+		return "", err
+	}
+
+	return name, nil
+}
+
+// Decode returns the decoded control log receipt.
+func (r *ControlLogReceipt) Decode() ([]byte, error) {
+	if r.GraphicImage == "" {
+		return nil, ErrNoGraphicImage
+	}
+
+	return decodeBase64(r.GraphicImage)
+}
+
+// Decode returns the decoded international forms document.
+func (i *FormImage) Decode() ([]byte, error) {
+	if i.GraphicImage == "" {
+		return nil, ErrNoGraphicImage
+	}
+
+	return decodeBase64(i.GraphicImage)
+}
+
+// Decode returns the decoded image.
+func (i *Image) Decode() ([]byte, error) {
+	if i.GraphicImage == "" {
+		return nil, ErrNoGraphicImage
+	}
+
+	return decodeBase64(i.GraphicImage)
+}
+
+// Format returns the format of the receipt. ImageFormat.Code is used if it is
+// known, otherwise the format is detected from the decoded image.
+func (r *ShippingReceipt) Format() LabelFormat {
+	if format := ParseLabelFormat(r.ImageFormat.Code); format != LabelFormatUnknown {
+		return format
+	}
+
+	data, err := decodeBase64(r.GraphicImage)
+	if err != nil {
+		return LabelFormatUnknown
+	}
+
+	return DetectLabelFormat(data)
+}
+
+// Decode returns the decoded receipt.
+func (r *ShippingReceipt) Decode() ([]byte, error) {
+	if r.GraphicImage == "" {
+		return nil, ErrNoGraphicImage
+	}
+
+	return decodeBase64(r.GraphicImage)
+}
+
+// WriteTo writes the decoded receipt into w.
+func (r *ShippingReceipt) WriteTo(w io.Writer) (int64, error) {
+	data, err := r.Decode()
+	if err != nil {
+		return 0, err
+	}
+
+	n, err := w.Write(data)
+	return int64(n), err
+}
+
+// WriteFile writes the decoded receipt into a file. The extension matching
+// the receipt format is appended to name if it is missing. The path of the
+// written file is returned.
+func (r *ShippingReceipt) WriteFile(name string) (string, error) {
+	data, err := r.Decode()
+	if err != nil {
+		return "", err
+	}
+
+	return writeFileWithExtension(name, r.Format().Extension(), data)
+}
+
+// WriteReceiptFile writes the receipt into dir as receipt<TrackingNumber>.<ext>.
+// The path of the written file is returned.
+func (p *PackageResults) WriteReceiptFile(dir string) (string, error) {
+	if p.ShippingReceipt == nil {
+		return "", ErrNoGraphicImage
+	}
+
+	return p.ShippingReceipt.WriteFile(filepath.Join(dir, "receipt"+p.TrackingNumber))
+}
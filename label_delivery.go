@@ -0,0 +1,59 @@
+package ups
+
+// Import control label methods of LabelMethod.Code.
+const (
+	LabelMethodPrintAndMail    = "01"
+	LabelMethodOneAttempt      = "02"
+	LabelMethodThreeAttempt    = "03"
+	LabelMethodElectronicLabel = "04"
+	LabelMethodPrintLabel      = "05"
+)
+
+func (s *Shipment) validateLabelDelivery() []error {
+	var errs []error
+
+	var (
+		delivery    *LabelDelivery
+		method      *LabelMethod
+		importLabel bool
+	)
+
+	if options := s.ShipmentServiceOptions; options != nil {
+		delivery = options.LabelDelivery
+		method = options.LabelMethod
+		importLabel = options.ImportControlIndicator != ""
+	}
+
+	if delivery != nil {
+		if delivery.EMail == nil && delivery.LabelLinksIndicator == "" {
+			errs = append(errs, newValidationError("Shipment.ShipmentServiceOptions.LabelDelivery", "an e-mail or the LabelLinksIndicator is required"))
+		}
+
+		if delivery.EMail != nil && delivery.EMail.EMailAddress == "" {
+			errs = append(errs, newValidationError("Shipment.ShipmentServiceOptions.LabelDelivery.EMail.EMailAddress", "is required"))
+		}
+	}
+
+	if s.ReturnService != nil && s.ReturnService.Code == ReturnServiceElectronicReturnLabel && (delivery == nil || delivery.EMail == nil) {
+		errs = append(errs, newValidationError("Shipment.ShipmentServiceOptions.LabelDelivery.EMail", "is required for Electronic Return Label shipments"))
+	}
+
+	switch {
+	case importLabel && method == nil:
+		errs = append(errs, newValidationError("Shipment.ShipmentServiceOptions.LabelMethod", "is required for ImportControl shipments"))
+	case !importLabel && method != nil:
+		errs = append(errs, newValidationError("Shipment.ShipmentServiceOptions.LabelMethod", "only valid for ImportControl shipments"))
+	case method != nil:
+		switch method.Code {
+		case LabelMethodPrintAndMail, LabelMethodOneAttempt, LabelMethodThreeAttempt, LabelMethodPrintLabel:
+		case LabelMethodElectronicLabel:
+			if delivery == nil || delivery.EMail == nil {
+				errs = append(errs, newValidationError("Shipment.ShipmentServiceOptions.LabelDelivery.EMail", "is required for Electronic Import Control Label shipments"))
+			}
+		default:
+			errs = append(errs, newValidationError("Shipment.ShipmentServiceOptions.LabelMethod.Code", "%q is not a valid label method", method.Code))
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,95 @@
+package ups
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidateLabelDelivery(t *testing.T) {
+	email := &LabelDeliveryEMail{EMailAddress: "receiver@example.com"}
+
+	tests := []struct {
+		name          string
+		returnService string
+		options       *ShipmentServiceOptions
+		want          []string
+	}{
+		{
+			name:    "e-mail",
+			options: &ShipmentServiceOptions{LabelDelivery: &LabelDelivery{EMail: email}},
+		},
+		{
+			name:    "label links",
+			options: &ShipmentServiceOptions{LabelDelivery: &LabelDelivery{LabelLinksIndicator: " "}},
+		},
+		{
+			name:    "empty label delivery",
+			options: &ShipmentServiceOptions{LabelDelivery: &LabelDelivery{}},
+			want:    []string{"Shipment.ShipmentServiceOptions.LabelDelivery"},
+		},
+		{
+			name:    "e-mail without address",
+			options: &ShipmentServiceOptions{LabelDelivery: &LabelDelivery{EMail: &LabelDeliveryEMail{FromName: "Shipper"}}},
+			want:    []string{"Shipment.ShipmentServiceOptions.LabelDelivery.EMail.EMailAddress"},
+		},
+		{
+			name:          "electronic return label",
+			returnService: ReturnServiceElectronicReturnLabel,
+			options:       &ShipmentServiceOptions{LabelDelivery: &LabelDelivery{EMail: email}},
+		},
+		{
+			name:          "electronic return label without e-mail",
+			returnService: ReturnServiceElectronicReturnLabel,
+			want:          []string{"Shipment.ShipmentServiceOptions.LabelDelivery.EMail"},
+		},
+		{
+			name:          "print return label without e-mail",
+			returnService: ReturnServicePrintReturnLabel,
+		},
+		{
+			name:    "import control",
+			options: &ShipmentServiceOptions{ImportControlIndicator: " ", LabelMethod: &LabelMethod{Code: LabelMethodPrintLabel}},
+		},
+		{
+			name:    "import control without label method",
+			options: &ShipmentServiceOptions{ImportControlIndicator: " "},
+			want:    []string{"Shipment.ShipmentServiceOptions.LabelMethod"},
+		},
+		{
+			name:    "label method without import control",
+			options: &ShipmentServiceOptions{LabelMethod: &LabelMethod{Code: LabelMethodPrintLabel}},
+			want:    []string{"Shipment.ShipmentServiceOptions.LabelMethod"},
+		},
+		{
+			name: "electronic import control label",
+			options: &ShipmentServiceOptions{
+				ImportControlIndicator: " ",
+				LabelMethod:            &LabelMethod{Code: LabelMethodElectronicLabel},
+				LabelDelivery:          &LabelDelivery{EMail: email},
+			},
+		},
+		{
+			name:    "electronic import control label without e-mail",
+			options: &ShipmentServiceOptions{ImportControlIndicator: " ", LabelMethod: &LabelMethod{Code: LabelMethodElectronicLabel}},
+			want:    []string{"Shipment.ShipmentServiceOptions.LabelDelivery.EMail"},
+		},
+		{
+			name:    "invalid label method",
+			options: &ShipmentServiceOptions{ImportControlIndicator: " ", LabelMethod: &LabelMethod{Code: "06"}},
+			want:    []string{"Shipment.ShipmentServiceOptions.LabelMethod.Code"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := testShipment("US", "US")
+			s.ShipmentServiceOptions = tt.options
+
+			if tt.returnService != "" {
+				s.ReturnService = &ReturnService{Code: tt.returnService}
+			}
+
+			checkValidationFields(t, errors.Join(s.validateLabelDelivery()...), tt.want)
+		})
+	}
+}
@@ -0,0 +1,263 @@
+package ups
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+)
+
+type LabelRecoveryRequest struct {
+	// Request container.
+	Request *LabelRecoveryRequestOptions `json:",omitempty"`
+	// Container for the label format. Defaults to GIF.
+	LabelSpecification *LabelRecoverySpecification `json:",omitempty"`
+	// Container to request a translated label.
+	Translate *Translate `json:",omitempty"`
+	// Tracking number of the package.
+	TrackingNumber string `validate:"min=1,max=18"`
+}
+
+type LabelRecoveryRequestOptions struct {
+	// Sub version of the request.
+	SubVersion string `json:",omitempty"`
+	// TransactionReference identifies transactions between client and
+	// server.
+	TransactionReference *TransactionReference `json:",omitempty"`
+}
+
+type LabelRecoverySpecification struct {
+	// Browser HTTPUserAgent String for GIF labels.
+	HTTPUserAgent string `json:",omitempty" validate:"max=64"`
+	// Format of the recovered label.
+	LabelImageFormat LabelImageFormat
+	// Label size for EPL, ZPL and SPL labels.
+	LabelStockSize *LabelStockSize `json:",omitempty"`
+}
+
+type Translate struct {
+	// Language of the label, e.g. eng, spa or deu.
+	LanguageCode string `validate:"len=3"`
+	// Dialect of the language, e.g. US, GB or 97.
+	DialectCode string `validate:"len=2"`
+	// Valid value: 01 = Label.
+	Code string `validate:"len=2"`
+}
+
+// LabelRecoveryOption configures the request of Client.RecoverLabel.
+type LabelRecoveryOption func(*LabelRecoveryRequest)
+
+// WithRecoveryLabelFormat requests the label in the given image format, e.g.
+// ZPL. UPS returns GIF labels by default.
+func WithRecoveryLabelFormat(format LabelFormat) LabelRecoveryOption {
+	return func(r *LabelRecoveryRequest) {
+		if r.LabelSpecification == nil {
+			r.LabelSpecification = &LabelRecoverySpecification{}
+		}
+
+		r.LabelSpecification.LabelImageFormat.Code = string(format)
+	}
+}
+
+// WithRecoveryLabelStockSize sets the label size of EPL, ZPL and SPL labels.
+func WithRecoveryLabelStockSize(size LabelStockSize) LabelRecoveryOption {
+	return func(r *LabelRecoveryRequest) {
+		if r.LabelSpecification == nil {
+			r.LabelSpecification = &LabelRecoverySpecification{}
+		}
+
+		r.LabelSpecification.LabelStockSize = &size
+	}
+}
+
+// WithTranslation requests the label translated into the language and
+// dialect, e.g. "deu" and "97".
+func WithTranslation(languageCode, dialectCode string) LabelRecoveryOption {
+	return func(r *LabelRecoveryRequest) {
+		r.Translate = &Translate{
+			LanguageCode: languageCode,
+			DialectCode:  dialectCode,
+			Code:         "01",
+		}
+	}
+}
+
+type LabelRecoveryResponse struct {
+	// Response container.
+	Response Response
+	// Returned UPS shipment ID number.
+	ShipmentIdentificationNumber string
+	// Recovered labels, one per package.
+	LabelResults []LabelResults
+	// The container of the COD Turn In Page.
+	CODTurnInPage *CODTurnInPage
+	// Container that holds the International Forms.
+	Form *Form
+}
+
+func (r *LabelRecoveryResponse) UnmarshalJSON(data []byte) error {
+	type alias LabelRecoveryResponse
+
+	var v struct {
+		alias
+		LabelResults json.RawMessage
+	}
+
+	err := json.Unmarshal(data, &v)
+	if err != nil {
+		return err
+	}
+
+	*r = LabelRecoveryResponse(v.alias)
+
+	if len(v.LabelResults) > 0 {
+		return unmarshalOneOrMany(v.LabelResults, &r.LabelResults)
+	}
+
+	return nil
+}
+
+type LabelResults struct {
+	// Package tracking number.
+	TrackingNumber string
+	// Container for the label image.
+	LabelImage *LabelImage
+	// Container for the receipt.
+	Receipt *Receipt
+}
+
+// ErrNoPDF is returned if a recovered label does not contain a PDF.
+var ErrNoPDF = errors.New("label contains no pdf")
+
+// LabelImage is a recovered label. It embeds ShippingLabel, so that it is
+// decoded and written like the labels of ShipmentResults, and adds the PDF
+// and URL only returned by the label recovery.
+type LabelImage struct {
+	ShippingLabel
+	// Base 64 encoded PDF of the label.
+	PDF string
+	// URL of the label if label links were requested.
+	URL string
+}
+
+func (l *LabelImage) UnmarshalJSON(data []byte) error {
+	// UPS names the format of a recovered label LabelImageFormat.
+	var v struct {
+		LabelImageFormat ImageFormat
+		GraphicImage     string
+		HTMLImage        string
+		PDF              string
+		URL              string
+	}
+
+	err := json.Unmarshal(data, &v)
+	if err != nil {
+		return err
+	}
+
+	*l = LabelImage{
+		ShippingLabel: ShippingLabel{
+			ImageFormat:  v.LabelImageFormat,
+			GraphicImage: v.GraphicImage,
+			HTMLImage:    v.HTMLImage,
+		},
+		PDF: v.PDF,
+		URL: v.URL,
+	}
+
+	return nil
+}
+
+// DecodePDF returns the decoded PDF of the label.
+func (l *LabelImage) DecodePDF() ([]byte, error) {
+	if l.PDF == "" {
+		return nil, ErrNoPDF
+	}
+
+	return decodeBase64(l.PDF)
+}
+
+type Receipt struct {
+	// Base 64 encoded HTML receipt.
+	HTMLImage string
+	// Container for the receipt image.
+	Image *Image
+}
+
+// ShippingLabel returns the recovered label including its HTML image. The PDF
+// is kept in LabelImage.
+func (r *LabelResults) ShippingLabel() *ShippingLabel {
+	if r.LabelImage == nil {
+		return nil
+	}
+
+	return &r.LabelImage.ShippingLabel
+}
+
+// ShippingReceipt returns the recovered receipt as ShippingReceipt. The image
+// is preferred over the HTML receipt.
+func (r *LabelResults) ShippingReceipt() *ShippingReceipt {
+	switch {
+	case r.Receipt == nil:
+		return nil
+	case r.Receipt.Image != nil && r.Receipt.Image.GraphicImage != "":
+		return &ShippingReceipt{
+			ImageFormat:  r.Receipt.Image.ImageFormat,
+			GraphicImage: r.Receipt.Image.GraphicImage,
+		}
+	case r.Receipt.HTMLImage != "":
+		return &ShippingReceipt{
+			ImageFormat:  ImageFormat{Code: string(LabelFormatHTML)},
+			GraphicImage: r.Receipt.HTMLImage,
+		}
+	}
+
+	return nil
+}
+
+// PackageResults returns the recovered labels and receipts as PackageResults
+// to be used with e.g. PackageResults.WriteLabelFiles.
+func (r *LabelRecoveryResponse) PackageResults() []PackageResults {
+	results := make([]PackageResults, len(r.LabelResults))
+
+	for i := range r.LabelResults {
+		results[i] = PackageResults{
+			TrackingNumber:  r.LabelResults[i].TrackingNumber,
+			ShippingLabel:   r.LabelResults[i].ShippingLabel(),
+			ShippingReceipt: r.LabelResults[i].ShippingReceipt(),
+		}
+	}
+
+	return results
+}
+
+// RecoverLabel fetches the label of an already created package again, e.g.
+// if it was lost before it was stored.
+func (c *Client) RecoverLabel(ctx context.Context, trackingNumber string, options ...LabelRecoveryOption) (*LabelRecoveryResponse, error) {
+	if trackingNumber == "" {
+		return nil, errors.New("tracking number is required")
+	}
+
+	request := LabelRecoveryRequest{
+		TrackingNumber: trackingNumber,
+	}
+
+	for _, option := range options {
+		option(&request)
+	}
+
+	var response struct {
+		LabelRecoveryResponse *LabelRecoveryResponse
+	}
+
+	err := c.do(ctx, http.MethodPost, labelRecoveryURL, struct {
+		LabelRecoveryRequest LabelRecoveryRequest
+	}{
+		LabelRecoveryRequest: request,
+	}, &response)
+	if err != nil {
+		return nil, err
+	}
+
+	return response.LabelRecoveryResponse, nil
+}
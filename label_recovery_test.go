@@ -0,0 +1,200 @@
+package ups
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"net/http"
+	"testing"
+)
+
+func TestRecoverLabel(t *testing.T) {
+	label := `{"TrackingNumber":"1Z999AA10123456784","LabelImage":{"LabelImageFormat":{"Code":"ZPL"},"GraphicImage":"` + encode(testZPL) + `"}}`
+	gifLabel := `{"TrackingNumber":"1Z999AA10123456784","LabelImage":{"LabelImageFormat":{"Code":"GIF"},"GraphicImage":"` + encode(testGIF) + `","HTMLImage":"` + encode([]byte("<html></html>")) + `"}}`
+	pdfLabel := `{"TrackingNumber":"1Z999AA10123456784","LabelImage":{"LabelImageFormat":{"Code":"PDF"},"GraphicImage":"` + encode(testPDF) + `","PDF":"` + encode(testPDF) + `"}}`
+
+	tests := []struct {
+		name            string
+		trackingNumber  string
+		options         []LabelRecoveryOption
+		response        func(w http.ResponseWriter)
+		wantRequest     LabelRecoveryRequest
+		wantLabels      int
+		wantFormat      LabelFormat
+		wantHTML        bool
+		wantPDF         bool
+		wantErrResponse bool
+		wantErr         bool
+	}{
+		{
+			name:           "single label",
+			trackingNumber: "1Z999AA10123456784",
+			response: func(w http.ResponseWriter) {
+				w.Write([]byte(`{"LabelRecoveryResponse":{"Response":{"ResponseStatus":{"Code":"1"}},"LabelResults":` + label + `}}`))
+			},
+			wantRequest: LabelRecoveryRequest{TrackingNumber: "1Z999AA10123456784"},
+			wantLabels:  1,
+			wantFormat:  LabelFormatZPL,
+		},
+		{
+			name:           "gif label with html image",
+			trackingNumber: "1Z999AA10123456784",
+			response: func(w http.ResponseWriter) {
+				w.Write([]byte(`{"LabelRecoveryResponse":{"Response":{"ResponseStatus":{"Code":"1"}},"LabelResults":` + gifLabel + `}}`))
+			},
+			wantRequest: LabelRecoveryRequest{TrackingNumber: "1Z999AA10123456784"},
+			wantLabels:  1,
+			wantFormat:  LabelFormatGIF,
+			wantHTML:    true,
+		},
+		{
+			name:           "pdf label",
+			trackingNumber: "1Z999AA10123456784",
+			options:        []LabelRecoveryOption{WithRecoveryLabelFormat(LabelFormatPDF)},
+			response: func(w http.ResponseWriter) {
+				w.Write([]byte(`{"LabelRecoveryResponse":{"Response":{"ResponseStatus":{"Code":"1"}},"LabelResults":` + pdfLabel + `}}`))
+			},
+			wantRequest: LabelRecoveryRequest{
+				TrackingNumber:     "1Z999AA10123456784",
+				LabelSpecification: &LabelRecoverySpecification{LabelImageFormat: LabelImageFormat{Code: "PDF"}},
+			},
+			wantLabels: 1,
+			wantFormat: LabelFormatPDF,
+			wantPDF:    true,
+		},
+		{
+			name:           "several labels with options",
+			trackingNumber: "1Z999AA10123456784",
+			options: []LabelRecoveryOption{
+				WithRecoveryLabelFormat(LabelFormatZPL),
+				WithRecoveryLabelStockSize(LabelStockSize{Height: "6", Width: "4"}),
+				WithTranslation("deu", "97"),
+			},
+			response: func(w http.ResponseWriter) {
+				w.Write([]byte(`{"LabelRecoveryResponse":{"Response":{"ResponseStatus":{"Code":"1"}},"LabelResults":[` + label + `,` + label + `]}}`))
+			},
+			wantRequest: LabelRecoveryRequest{
+				TrackingNumber: "1Z999AA10123456784",
+				LabelSpecification: &LabelRecoverySpecification{
+					LabelImageFormat: LabelImageFormat{Code: "ZPL"},
+					LabelStockSize:   &LabelStockSize{Height: "6", Width: "4"},
+				},
+				Translate: &Translate{LanguageCode: "deu", DialectCode: "97", Code: "01"},
+			},
+			wantLabels: 2,
+			wantFormat: LabelFormatZPL,
+		},
+		{
+			name:           "error response",
+			trackingNumber: "1Z999AA10123456784",
+			response: func(w http.ResponseWriter) {
+				writeErrorResponse(w, http.StatusBadRequest, "190101")
+			},
+			wantRequest:     LabelRecoveryRequest{TrackingNumber: "1Z999AA10123456784"},
+			wantErrResponse: true,
+			wantErr:         true,
+		},
+		{
+			name:    "no tracking number",
+			wantErr: true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.URL.Path != labelRecoveryURL {
+					t.Errorf("request to %s", r.URL.Path)
+				}
+
+				var request struct {
+					LabelRecoveryRequest LabelRecoveryRequest
+				}
+
+				if err := json.NewDecoder(r.Body).Decode(&request); err != nil {
+					t.Fatal(err)
+				}
+
+				got, _ := json.Marshal(request.LabelRecoveryRequest)
+				want, _ := json.Marshal(tt.wantRequest)
+				if string(got) != string(want) {
+					t.Errorf("LabelRecoveryRequest = %s, want %s", got, want)
+				}
+
+				tt.response(w)
+			})
+
+			response, err := c.RecoverLabel(context.Background(), tt.trackingNumber, tt.options...)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("RecoverLabel() error = %v, want error %t", err, tt.wantErr)
+			}
+
+			var errorResponse *ErrorResponse
+			if errors.As(err, &errorResponse) != tt.wantErrResponse {
+				t.Errorf("RecoverLabel() error = %v, want ErrorResponse %t", err, tt.wantErrResponse)
+			}
+
+			if err != nil {
+				return
+			}
+
+			results := response.PackageResults()
+			if len(results) != tt.wantLabels {
+				t.Fatalf("PackageResults() = %d results, want %d", len(results), tt.wantLabels)
+			}
+
+			for i, result := range results {
+				if result.TrackingNumber != "1Z999AA10123456784" || result.ShippingLabel == nil || result.ShippingLabel.Format() != tt.wantFormat {
+					t.Fatalf("PackageResults() = %+v, want %s label", result, tt.wantFormat)
+				}
+
+				if _, err := result.ShippingLabel.DecodeHTML(); (err == nil) != tt.wantHTML {
+					t.Errorf("DecodeHTML() error = %v, want html image %t", err, tt.wantHTML)
+				}
+
+				pdf, err := response.LabelResults[i].LabelImage.DecodePDF()
+				if tt.wantPDF && (err != nil || !bytes.Equal(pdf, testPDF)) {
+					t.Errorf("DecodePDF() = %q, %v, want %q", pdf, err, testPDF)
+				} else if !tt.wantPDF && !errors.Is(err, ErrNoPDF) {
+					t.Errorf("DecodePDF() error = %v, want %v", err, ErrNoPDF)
+				}
+			}
+		})
+	}
+}
+
+func TestLabelResultsShippingReceipt(t *testing.T) {
+	html := encode([]byte("<html></html>"))
+
+	tests := []struct {
+		name       string
+		receipt    *Receipt
+		wantFormat LabelFormat
+		wantImage  string
+	}{
+		{"no receipt", nil, "", ""},
+		{"html", &Receipt{HTMLImage: html}, LabelFormatHTML, html},
+		{"image", &Receipt{HTMLImage: html, Image: &Image{ImageFormat: ImageFormat{Code: "ZPL"}, GraphicImage: encode(testZPL)}}, LabelFormatZPL, encode(testZPL)},
+		{"empty image", &Receipt{HTMLImage: html, Image: &Image{ImageFormat: ImageFormat{Code: "ZPL"}}}, LabelFormatHTML, html},
+		{"empty receipt", &Receipt{}, "", ""},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			results := LabelResults{Receipt: tt.receipt}
+
+			receipt := results.ShippingReceipt()
+			if tt.wantImage == "" {
+				if receipt != nil {
+					t.Errorf("ShippingReceipt() = %+v, want nil", receipt)
+				}
+				return
+			}
+
+			if receipt == nil || receipt.Format() != tt.wantFormat || receipt.GraphicImage != tt.wantImage {
+				t.Errorf("ShippingReceipt() = %+v, want %s receipt", receipt, tt.wantFormat)
+			}
+		})
+	}
+}
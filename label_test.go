@@ -0,0 +1,279 @@
+package ups
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+var (
+	testGIF = []byte("GIF89a\x01\x00\x01\x00")
+	testPNG = []byte("\x89PNG\r\n\x1a\n\x00\x00")
+	testZPL = []byte("^XA^FO10,10^FDtest^FS^XZ")
+	testEPL = []byte("N\nA10,10,0,1,1,1,N,\"test\"\nP1\n")
+	testSPL = []byte("\x02L\nD11\n")
+	testPDF = []byte("%PDF-1.4\n%%EOF\n")
+)
+
+func encode(data []byte) string {
+	return base64.StdEncoding.EncodeToString(data)
+}
+
+func TestShippingLabelWriteFiles(t *testing.T) {
+	tests := []struct {
+		name      string
+		label     ShippingLabel
+		wantFiles map[string][]byte
+	}{
+		{
+			name:      "gif",
+			label:     ShippingLabel{ImageFormat: ImageFormat{Code: "GIF"}, GraphicImage: encode(testGIF)},
+			wantFiles: map[string][]byte{"label.gif": testGIF},
+		},
+		{
+			name:      "gif with part",
+			label:     ShippingLabel{ImageFormat: ImageFormat{Code: "GIF"}, GraphicImage: encode(testGIF), GraphicImagePart: encode(testGIF)},
+			wantFiles: map[string][]byte{"label.gif": testGIF, "label_part.gif": testGIF},
+		},
+		{
+			name:      "png with part",
+			label:     ShippingLabel{ImageFormat: ImageFormat{Code: "PNG"}, GraphicImage: encode(testPNG), GraphicImagePart: encode(testPNG)},
+			wantFiles: map[string][]byte{"label.png": testPNG, "label_part.png": testPNG},
+		},
+		{
+			name:      "zpl with part",
+			label:     ShippingLabel{ImageFormat: ImageFormat{Code: "ZPL"}, GraphicImage: encode(testZPL), GraphicImagePart: encode(testZPL)},
+			wantFiles: map[string][]byte{"label.zpl": append(append([]byte{}, testZPL...), testZPL...)},
+		},
+		{
+			name:      "epl",
+			label:     ShippingLabel{ImageFormat: ImageFormat{Code: "EPL"}, GraphicImage: encode(testEPL)},
+			wantFiles: map[string][]byte{"label.epl": testEPL},
+		},
+		{
+			name:      "spl",
+			label:     ShippingLabel{ImageFormat: ImageFormat{Code: "SPL"}, GraphicImage: encode(testSPL)},
+			wantFiles: map[string][]byte{"label.spl": testSPL},
+		},
+		{
+			name:      "pdf",
+			label:     ShippingLabel{ImageFormat: ImageFormat{Code: "PDF"}, GraphicImage: encode(testPDF)},
+			wantFiles: map[string][]byte{"label.pdf": testPDF},
+		},
+		{
+			name:      "detected format",
+			label:     ShippingLabel{GraphicImage: encode(testPNG)},
+			wantFiles: map[string][]byte{"label.png": testPNG},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			dir := t.TempDir()
+
+			paths, err := tt.label.WriteFiles(filepath.Join(dir, "label"))
+			if err != nil {
+				t.Fatalf("WriteFiles() error = %v", err)
+			}
+
+			if len(paths) != len(tt.wantFiles) {
+				t.Fatalf("WriteFiles() wrote %v, want %d files", paths, len(tt.wantFiles))
+			}
+
+			for file, want := range tt.wantFiles {
+				got, err := os.ReadFile(filepath.Join(dir, file))
+				if err != nil {
+					t.Fatalf("read %s: %v", file, err)
+				}
+
+				if !bytes.Equal(got, want) {
+					t.Errorf("%s = %q, want %q", file, got, want)
+				}
+			}
+		})
+	}
+}
+
+func TestShippingLabelRasterParts(t *testing.T) {
+	label := ShippingLabel{ImageFormat: ImageFormat{Code: "GIF"}, GraphicImage: encode(testGIF), GraphicImagePart: encode(testGIF)}
+
+	if _, err := label.Decode(); !errors.Is(err, ErrRasterParts) {
+		t.Errorf("Decode() error = %v, want %v", err, ErrRasterParts)
+	}
+
+	if _, err := label.WriteTo(&bytes.Buffer{}); !errors.Is(err, ErrRasterParts) {
+		t.Errorf("WriteTo() error = %v, want %v", err, ErrRasterParts)
+	}
+
+	if _, err := label.WriteFile(filepath.Join(t.TempDir(), "label")); !errors.Is(err, ErrRasterParts) {
+		t.Errorf("WriteFile() error = %v, want %v", err, ErrRasterParts)
+	}
+
+	parts, err := label.DecodeParts()
+	if err != nil || len(parts) != 2 {
+		t.Errorf("DecodeParts() = %d parts, %v, want 2 parts", len(parts), err)
+	}
+}
+
+func TestShippingLabelWriteTo(t *testing.T) {
+	tests := []struct {
+		name  string
+		label ShippingLabel
+		want  []byte
+	}{
+		{"gif", ShippingLabel{ImageFormat: ImageFormat{Code: "GIF"}, GraphicImage: encode(testGIF)}, testGIF},
+		{"zpl with part", ShippingLabel{ImageFormat: ImageFormat{Code: "ZPL"}, GraphicImage: encode(testZPL), GraphicImagePart: encode([]byte("^XA^XZ"))}, []byte("^XA^FO10,10^FDtest^FS^XZ^XA^XZ")},
+		{"epl", ShippingLabel{ImageFormat: ImageFormat{Code: "EPL"}, GraphicImage: encode(testEPL)}, testEPL},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			n, err := tt.label.WriteTo(&buf)
+			if err != nil {
+				t.Fatalf("WriteTo() error = %v", err)
+			}
+
+			if n != int64(len(tt.want)) || !bytes.Equal(buf.Bytes(), tt.want) {
+				t.Errorf("WriteTo() = %q (%d), want %q", buf.Bytes(), n, tt.want)
+			}
+		})
+	}
+}
+
+func TestPackageResultsWriteLabelFiles(t *testing.T) {
+	dir := t.TempDir()
+	result := PackageResults{
+		TrackingNumber: "1Z999AA10123456784",
+		ShippingLabel: &ShippingLabel{
+			ImageFormat:      ImageFormat{Code: "GIF"},
+			GraphicImage:     encode(testGIF),
+			GraphicImagePart: encode(testGIF),
+			HTMLImage:        encode([]byte("<html></html>")),
+		},
+	}
+
+	paths, err := result.WriteLabelFiles(dir)
+	if err != nil {
+		t.Fatalf("WriteLabelFiles() error = %v", err)
+	}
+
+	want := []string{
+		filepath.Join(dir, "label1Z999AA10123456784.gif"),
+		filepath.Join(dir, "label1Z999AA10123456784_part.gif"),
+		filepath.Join(dir, "1Z999AA10123456784.html"),
+	}
+
+	if len(paths) != len(want) {
+		t.Fatalf("WriteLabelFiles() = %v, want %v", paths, want)
+	}
+
+	for i := range want {
+		if paths[i] != want[i] {
+			t.Errorf("WriteLabelFiles()[%d] = %s, want %s", i, paths[i], want[i])
+		}
+	}
+}
+
+func TestDetectLabelFormat(t *testing.T) {
+	tests := []struct {
+		data []byte
+		want LabelFormat
+	}{
+		{testGIF, LabelFormatGIF},
+		{testPNG, LabelFormatPNG},
+		{testZPL, LabelFormatZPL},
+		{[]byte("\r\n^XA^XZ"), LabelFormatZPL},
+		{testEPL, LabelFormatEPL},
+		{[]byte("<!DOCTYPE html><html></html>"), LabelFormatHTML},
+		{testPDF, LabelFormatPDF},
+		{[]byte("unknown"), LabelFormatUnknown},
+	}
+
+	for _, tt := range tests {
+		if got := DetectLabelFormat(tt.data); got != tt.want {
+			t.Errorf("DetectLabelFormat(%q) = %q, want %q", tt.data, got, tt.want)
+		}
+	}
+}
+
+func TestShippingReceiptWriteFile(t *testing.T) {
+	html := []byte("<html><body>receipt</body></html>")
+
+	tests := []struct {
+		name       string
+		receipt    ShippingReceipt
+		wantFormat LabelFormat
+		wantExt    string
+		wantErr    error
+	}{
+		{"html", ShippingReceipt{ImageFormat: ImageFormat{Code: "HTML"}, GraphicImage: encode(html)}, LabelFormatHTML, ".html", nil},
+		{"star printer", ShippingReceipt{ImageFormat: ImageFormat{Code: "STARPL"}, GraphicImage: encode(testEPL)}, LabelFormatStarPL, ".prn", nil},
+		{"zpl", ShippingReceipt{ImageFormat: ImageFormat{Code: "ZPL"}, GraphicImage: encode(testZPL)}, LabelFormatZPL, ".zpl", nil},
+		{"detected format", ShippingReceipt{GraphicImage: encode(html)}, LabelFormatHTML, ".html", nil},
+		{"no image", ShippingReceipt{ImageFormat: ImageFormat{Code: "HTML"}}, LabelFormatHTML, "", ErrNoGraphicImage},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := tt.receipt.Format(); got != tt.wantFormat {
+				t.Errorf("Format() = %q, want %q", got, tt.wantFormat)
+			}
+
+			dir := t.TempDir()
+			result := PackageResults{TrackingNumber: "1Z999AA10123456784", ShippingReceipt: &tt.receipt}
+
+			path, err := result.WriteReceiptFile(dir)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("WriteReceiptFile() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if want := filepath.Join(dir, "receipt1Z999AA10123456784"+tt.wantExt); path != want {
+				t.Errorf("WriteReceiptFile() = %s, want %s", path, want)
+			}
+
+			want, _ := tt.receipt.Decode()
+			if got, err := os.ReadFile(path); err != nil || !bytes.Equal(got, want) {
+				t.Errorf("WriteReceiptFile() wrote %q, want %q (error %v)", got, want, err)
+			}
+		})
+	}
+
+	if _, err := (&PackageResults{}).WriteReceiptFile(t.TempDir()); !errors.Is(err, ErrNoGraphicImage) {
+		t.Errorf("WriteReceiptFile() without receipt error = %v, want %v", err, ErrNoGraphicImage)
+	}
+}
+
+func TestValidateReceiptSpecification(t *testing.T) {
+	tests := []struct {
+		code string
+		want []string
+	}{
+		{"HTML", nil},
+		{"EPL", nil},
+		{"SPL", nil},
+		{"ZPL", nil},
+		{"STARPL", nil},
+		{"GIF", []string{"ReceiptSpecification.ImageFormat.Code"}},
+		{"", []string{"ReceiptSpecification.ImageFormat.Code"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.code, func(t *testing.T) {
+			r := ShipmentRequest{
+				Shipment:             testShipment("DE", "DE"),
+				ReceiptSpecification: &ReceiptSpecification{ImageFormat: ReceiptImageFormat{Code: tt.code}},
+			}
+
+			checkValidationFields(t, r.Validate(), tt.want)
+		})
+	}
+}
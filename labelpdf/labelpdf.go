@@ -0,0 +1,327 @@
+// Package labelpdf renders the labels of a ups.ShipmentResponse into a single
+// printable PDF document.
+package labelpdf
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"image"
+	_ "image/gif"
+	_ "image/png"
+	"io"
+	"strings"
+
+	"github.com/enthus-golang/ups"
+)
+
+// ErrUnsupportedFormat is returned for labels which are not GIF or PNG images
+// and for forms which are neither images nor PDF. ZPL, EPL and SPL labels have
+// to be sent to a thermal printer.
+var ErrUnsupportedFormat = errors.New("unsupported image format")
+
+// Rect is an area on a page in PDF points (1/72 inch) with the origin in the
+// lower left corner.
+type Rect struct {
+	X, Y          float64
+	Width, Height float64
+}
+
+// Layout defines the page size and the slots labels are placed into. Labels
+// fill the slots in order, a new page is started when all slots are used.
+type Layout struct {
+	Width, Height float64
+	Slots         []Rect
+}
+
+const (
+	inch     = 72.0
+	a4Width  = 595.28
+	a4Height = 841.89
+	a4Margin = 28.35
+)
+
+var (
+	// Thermal4x6 prints one label per 4x6 inch page.
+	Thermal4x6 = Layout{
+		Width:  4 * inch,
+		Height: 6 * inch,
+		Slots:  []Rect{{Width: 4 * inch, Height: 6 * inch}},
+	}
+	// A4 prints two labels per A4 page, the first on the upper half.
+	A4 = Layout{
+		Width:  a4Width,
+		Height: a4Height,
+		Slots: []Rect{
+			{X: a4Margin, Y: a4Height/2 + a4Margin, Width: a4Width - 2*a4Margin, Height: a4Height/2 - 2*a4Margin},
+			{X: a4Margin, Y: a4Margin, Width: a4Width - 2*a4Margin, Height: a4Height/2 - 2*a4Margin},
+		},
+	}
+)
+
+// Rotation defines how images are rotated before they are placed into a slot.
+type Rotation int
+
+const (
+	// RotateAuto rotates images clockwise if their orientation does not
+	// match the orientation of the slot. UPS returns GIF labels in
+	// landscape, so they are turned upright on a 4x6 page.
+	RotateAuto Rotation = iota
+	// RotateNone never rotates images.
+	RotateNone
+	// Rotate90 always rotates images 90 degrees clockwise.
+	Rotate90
+	// Rotate270 always rotates images 90 degrees counterclockwise.
+	Rotate270
+)
+
+type Renderer struct {
+	layout   Layout
+	rotation Rotation
+	forms    bool
+	receipts bool
+}
+
+type OptionFunction func(*Renderer)
+
+func New(options ...OptionFunction) *Renderer {
+	r := &Renderer{
+		layout: Thermal4x6,
+	}
+
+	for _, option := range options {
+		option(r)
+	}
+
+	return r
+}
+
+// WithLayout defines the page size and label placement. Thermal4x6 is used by
+// default.
+func WithLayout(layout Layout) OptionFunction {
+	return func(r *Renderer) {
+		r.layout = layout
+	}
+}
+
+// WithRotation defines how labels are rotated. RotateAuto is used by default.
+func WithRotation(rotation Rotation) OptionFunction {
+	return func(r *Renderer) {
+		r.rotation = rotation
+	}
+}
+
+// WithAttachedInternationalForms adds the international forms. UPS returns
+// them as PDF, which is not printed as pages of the document but attached as
+// embedded file "InternationalForms.pdf" and opened from the attachments
+// panel of the PDF viewer. Forms returned as GIF or PNG are appended on a
+// separate page.
+func WithAttachedInternationalForms() OptionFunction {
+	return func(r *Renderer) {
+		r.forms = true
+	}
+}
+
+// WithReceipts appends the GIF and PNG control log receipts on separate
+// pages. HTML, EPL, ZPL and SPL receipts can't be rendered and are skipped.
+func WithReceipts() OptionFunction {
+	return func(r *Renderer) {
+		r.receipts = true
+	}
+}
+
+// Render writes a PDF containing the labels of all packages of the response
+// into w.
+func (r *Renderer) Render(w io.Writer, response *ups.ShipmentResponse) error {
+	if response == nil {
+		return errors.New("no shipment response")
+	}
+
+	if len(r.layout.Slots) == 0 {
+		return errors.New("layout contains no slots")
+	}
+
+	var labels []image.Image
+
+	for _, result := range response.ShipmentResults.PackageResults {
+		if result.ShippingLabel == nil {
+			continue
+		}
+
+		images, err := decodeLabel(result.ShippingLabel)
+		if err != nil {
+			return fmt.Errorf("label %s: %w", result.TrackingNumber, err)
+		}
+
+		labels = append(labels, images...)
+	}
+
+	var pages []image.Image
+
+	doc := &document{}
+
+	if r.forms && response.ShipmentResults.Form != nil && response.ShipmentResults.Form.Image != nil {
+		form := response.ShipmentResults.Form.Image
+
+		data, err := form.Decode()
+		if err != nil {
+			return fmt.Errorf("international forms: %w", err)
+		}
+
+		switch {
+		case isPDF(form.ImageFormat.Code, data):
+			doc.addAttachment(formsFileName, "application/pdf", data)
+		case isRaster(form.ImageFormat.Code, data):
+			img, _, err := image.Decode(bytes.NewReader(data))
+			if err != nil {
+				return fmt.Errorf("international forms: %w", err)
+			}
+
+			pages = append(pages, img)
+		default:
+			return fmt.Errorf("international forms: %w: %s", ErrUnsupportedFormat, form.ImageFormat.Code)
+		}
+	}
+
+	if r.receipts {
+		for i := range response.ShipmentResults.ControlLogReceipts {
+			receipt := &response.ShipmentResults.ControlLogReceipts[i]
+
+			data, err := receipt.Decode()
+			if err != nil {
+				return fmt.Errorf("control log receipt: %w", err)
+			}
+
+			if !isRaster(receipt.ImageFormat.Code, data) {
+				continue
+			}
+
+			img, _, err := image.Decode(bytes.NewReader(data))
+			if err != nil {
+				return fmt.Errorf("control log receipt: %w", err)
+			}
+
+			pages = append(pages, img)
+		}
+	}
+
+	return r.render(w, doc, labels, pages)
+}
+
+// formsFileName is the name of the attached international forms.
+const formsFileName = "InternationalForms.pdf"
+
+func (r *Renderer) render(w io.Writer, doc *document, labels, pages []image.Image) error {
+	for len(labels) > 0 {
+		n := min(len(labels), len(r.layout.Slots))
+
+		placements := make([]placement, n)
+		for i, img := range labels[:n] {
+			p, err := r.place(doc, img, r.layout.Slots[i])
+			if err != nil {
+				return err
+			}
+
+			placements[i] = p
+		}
+
+		doc.addPage(r.layout.Width, r.layout.Height, placements)
+		labels = labels[n:]
+	}
+
+	full := Rect{X: inch / 4, Y: inch / 4, Width: r.layout.Width - inch/2, Height: r.layout.Height - inch/2}
+
+	for _, img := range pages {
+		p, err := r.place(doc, img, full)
+		if err != nil {
+			return err
+		}
+
+		doc.addPage(r.layout.Width, r.layout.Height, []placement{p})
+	}
+
+	return doc.writeTo(w)
+}
+
+// place scales img to fit into slot, keeping its aspect ratio, and centers it.
+func (r *Renderer) place(doc *document, img image.Image, slot Rect) (placement, error) {
+	id, err := doc.addImage(img)
+	if err != nil {
+		return placement{}, err
+	}
+
+	imgWidth, imgHeight := float64(img.Bounds().Dx()), float64(img.Bounds().Dy())
+
+	rotation := r.rotation
+	if rotation == RotateAuto {
+		rotation = RotateNone
+		if (imgWidth > imgHeight) != (slot.Width > slot.Height) {
+			rotation = Rotate90
+		}
+	}
+
+	if rotation != RotateNone {
+		imgWidth, imgHeight = imgHeight, imgWidth
+	}
+
+	scale := min(slot.Width/imgWidth, slot.Height/imgHeight)
+	width, height := imgWidth*scale, imgHeight*scale
+	x := slot.X + (slot.Width-width)/2
+	y := slot.Y + (slot.Height-height)/2
+
+	var matrix [6]float64
+
+	switch rotation {
+	case Rotate90:
+		matrix = [6]float64{0, -height, width, 0, x, y + height}
+	case Rotate270:
+		matrix = [6]float64{0, height, -width, 0, x + width, y}
+	default:
+		matrix = [6]float64{width, 0, 0, height, x, y}
+	}
+
+	return placement{image: id, matrix: matrix}, nil
+}
+
+func decodeLabel(label *ups.ShippingLabel) ([]image.Image, error) {
+	format := label.Format()
+	if !format.IsRaster() {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedFormat, format)
+	}
+
+	parts, err := label.DecodeParts()
+	if err != nil {
+		return nil, err
+	}
+
+	images := make([]image.Image, len(parts))
+	for i, part := range parts {
+		images[i], _, err = image.Decode(bytes.NewReader(part))
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return images, nil
+}
+
+// isRaster reports whether the image format code or, for unknown codes, the
+// data denotes a GIF or PNG image.
+func isRaster(code string, data []byte) bool {
+	format := ups.ParseLabelFormat(code)
+	if format == ups.LabelFormatUnknown {
+		format = ups.DetectLabelFormat(data)
+	}
+
+	return format.IsRaster()
+}
+
+// isPDF reports whether the image format code or, for unknown codes, the data
+// denotes a PDF document.
+func isPDF(code string, data []byte) bool {
+	if strings.EqualFold(strings.TrimSpace(code), "PDF") {
+		return true
+	}
+
+	return ups.ParseLabelFormat(code) == ups.LabelFormatUnknown && bytes.HasPrefix(data, []byte("%PDF-"))
+}
@@ -0,0 +1,217 @@
+package labelpdf
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/png"
+	"testing"
+
+	"github.com/enthus-golang/ups"
+)
+
+func testImage(width, height int) image.Image {
+	img := image.NewGray(image.Rect(0, 0, width, height))
+	for x := 0; x < width; x++ {
+		img.SetGray(x, x%height, color.Gray{Y: 0xff})
+	}
+
+	return img
+}
+
+func encodePNG(t *testing.T, img image.Image) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		t.Fatal(err)
+	}
+
+	return base64.StdEncoding.EncodeToString(buf.Bytes())
+}
+
+func encodeGIF(t *testing.T, img image.Image) string {
+	t.Helper()
+
+	var buf bytes.Buffer
+	if err := gif.Encode(&buf, img, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	return base64.StdEncoding.EncodeToString(buf.Bytes())
+}
+
+var testFormPDF = []byte("%PDF-1.4\n1 0 obj\n<< /Type /Catalog >>\nendobj\ntrailer\n<< /Root 1 0 R >>\n%%EOF\n")
+
+func TestRender(t *testing.T) {
+	gifLabel := encodeGIF(t, testImage(40, 20))
+	pngLabel := encodePNG(t, testImage(20, 40))
+	form := &ups.Form{Image: &ups.FormImage{
+		ImageFormat:  ups.ImageFormat{Code: "PDF"},
+		GraphicImage: base64.StdEncoding.EncodeToString(testFormPDF),
+	}}
+	receipts := []ups.ControlLogReceipt{
+		{ImageFormat: ups.ImageFormat{Code: "HTML"}, GraphicImage: base64.StdEncoding.EncodeToString([]byte("<html></html>"))},
+		{ImageFormat: ups.ImageFormat{Code: "ZPL"}, GraphicImage: base64.StdEncoding.EncodeToString([]byte("^XA^XZ"))},
+		{ImageFormat: ups.ImageFormat{Code: "PNG"}, GraphicImage: pngLabel},
+	}
+
+	tests := []struct {
+		name           string
+		options        []OptionFunction
+		results        ups.ShipmentResults
+		wantPages      int
+		wantAttachment bool
+	}{
+		{
+			name: "labels",
+			results: ups.ShipmentResults{PackageResults: []ups.PackageResults{
+				{TrackingNumber: "1", ShippingLabel: &ups.ShippingLabel{ImageFormat: ups.ImageFormat{Code: "GIF"}, GraphicImage: gifLabel}},
+				{TrackingNumber: "2", ShippingLabel: &ups.ShippingLabel{ImageFormat: ups.ImageFormat{Code: "PNG"}, GraphicImage: pngLabel}},
+			}},
+			wantPages: 2,
+		},
+		{
+			name:    "two labels per A4 page",
+			options: []OptionFunction{WithLayout(A4)},
+			results: ups.ShipmentResults{PackageResults: []ups.PackageResults{
+				{TrackingNumber: "1", ShippingLabel: &ups.ShippingLabel{ImageFormat: ups.ImageFormat{Code: "GIF"}, GraphicImage: gifLabel}},
+				{TrackingNumber: "2", ShippingLabel: &ups.ShippingLabel{ImageFormat: ups.ImageFormat{Code: "GIF"}, GraphicImage: gifLabel}},
+				{TrackingNumber: "3", ShippingLabel: &ups.ShippingLabel{ImageFormat: ups.ImageFormat{Code: "GIF"}, GraphicImage: gifLabel}},
+			}},
+			wantPages: 2,
+		},
+		{
+			name:    "forms and receipts",
+			options: []OptionFunction{WithAttachedInternationalForms(), WithReceipts()},
+			results: ups.ShipmentResults{
+				PackageResults: []ups.PackageResults{
+					{TrackingNumber: "1", ShippingLabel: &ups.ShippingLabel{ImageFormat: ups.ImageFormat{Code: "GIF"}, GraphicImage: gifLabel}},
+				},
+				Form:               form,
+				ControlLogReceipts: receipts,
+			},
+			wantPages:      2,
+			wantAttachment: true,
+		},
+		{
+			name: "forms and receipts not requested",
+			results: ups.ShipmentResults{
+				PackageResults: []ups.PackageResults{
+					{TrackingNumber: "1", ShippingLabel: &ups.ShippingLabel{ImageFormat: ups.ImageFormat{Code: "GIF"}, GraphicImage: gifLabel}},
+				},
+				Form:               form,
+				ControlLogReceipts: receipts,
+			},
+			wantPages: 1,
+		},
+		{
+			name:    "raster form",
+			options: []OptionFunction{WithAttachedInternationalForms()},
+			results: ups.ShipmentResults{
+				PackageResults: []ups.PackageResults{
+					{TrackingNumber: "1", ShippingLabel: &ups.ShippingLabel{ImageFormat: ups.ImageFormat{Code: "GIF"}, GraphicImage: gifLabel}},
+				},
+				Form: &ups.Form{Image: &ups.FormImage{ImageFormat: ups.ImageFormat{Code: "PNG"}, GraphicImage: pngLabel}},
+			},
+			wantPages: 2,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var buf bytes.Buffer
+
+			err := New(tt.options...).Render(&buf, &ups.ShipmentResponse{ShipmentResults: tt.results})
+			if err != nil {
+				t.Fatalf("Render() error = %v", err)
+			}
+
+			out := buf.Bytes()
+			if !bytes.HasPrefix(out, []byte("%PDF-1.4\n")) || !bytes.HasSuffix(out, []byte("%%EOF\n")) {
+				t.Fatalf("Render() did not write a PDF document")
+			}
+
+			if got := bytes.Count(out, []byte("/Type /Page ")); got != tt.wantPages {
+				t.Errorf("Render() wrote %d pages, want %d", got, tt.wantPages)
+			}
+
+			hasAttachment := bytes.Contains(out, []byte("/EmbeddedFiles << /Names [(InternationalForms.pdf)"))
+			if hasAttachment != tt.wantAttachment {
+				t.Errorf("Render() attachment = %t, want %t", hasAttachment, tt.wantAttachment)
+			}
+
+			if tt.wantAttachment && !bytes.Contains(out, testFormPDF) {
+				t.Errorf("Render() did not embed the form")
+			}
+		})
+	}
+}
+
+func TestRenderUnsupportedFormat(t *testing.T) {
+	tests := []struct {
+		name    string
+		options []OptionFunction
+		results ups.ShipmentResults
+	}{
+		{
+			name: "zpl label",
+			results: ups.ShipmentResults{PackageResults: []ups.PackageResults{
+				{TrackingNumber: "1", ShippingLabel: &ups.ShippingLabel{ImageFormat: ups.ImageFormat{Code: "ZPL"}, GraphicImage: base64.StdEncoding.EncodeToString([]byte("^XA^XZ"))}},
+			}},
+		},
+		{
+			name:    "html form",
+			options: []OptionFunction{WithAttachedInternationalForms()},
+			results: ups.ShipmentResults{
+				Form: &ups.Form{Image: &ups.FormImage{ImageFormat: ups.ImageFormat{Code: "HTML"}, GraphicImage: base64.StdEncoding.EncodeToString([]byte("<html></html>"))}},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := New(tt.options...).Render(&bytes.Buffer{}, &ups.ShipmentResponse{ShipmentResults: tt.results})
+			if !errors.Is(err, ErrUnsupportedFormat) {
+				t.Errorf("Render() error = %v, want %v", err, ErrUnsupportedFormat)
+			}
+		})
+	}
+}
+
+func TestRenderNilResponse(t *testing.T) {
+	if err := New().Render(&bytes.Buffer{}, nil); err == nil {
+		t.Error("Render() of a nil response error = nil, want error")
+	}
+}
+
+func TestPlaceRotation(t *testing.T) {
+	tests := []struct {
+		name     string
+		rotation Rotation
+		img      image.Image
+		want     [6]float64
+	}{
+		{"auto landscape into portrait", RotateAuto, testImage(60, 40), [6]float64{0, -432, 288, 0, 0, 432}},
+		{"auto portrait into portrait", RotateAuto, testImage(40, 60), [6]float64{288, 0, 0, 432, 0, 0}},
+		{"none", RotateNone, testImage(60, 40), [6]float64{288, 0, 0, 192, 0, 120}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := New(WithRotation(tt.rotation))
+
+			p, err := r.place(&document{}, tt.img, Thermal4x6.Slots[0])
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if p.matrix != tt.want {
+				t.Errorf("place() matrix = %v, want %v", p.matrix, tt.want)
+			}
+		})
+	}
+}
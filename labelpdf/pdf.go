@@ -0,0 +1,201 @@
+package labelpdf
+
+import (
+	"bufio"
+	"bytes"
+	"compress/zlib"
+	"fmt"
+	"image"
+	"image/color"
+	"io"
+	"slices"
+	"strings"
+)
+
+// document is a minimal PDF writer which only supports pages containing
+// images and embedded files.
+type document struct {
+	objects     [][]byte
+	pages       []int
+	attachments []attachment
+}
+
+// attachment is an embedded file referenced by its file specification.
+type attachment struct {
+	name string
+	spec int
+}
+
+func (d *document) reserve() int {
+	d.objects = append(d.objects, nil)
+	return len(d.objects)
+}
+
+func (d *document) set(id int, object []byte) {
+	d.objects[id-1] = object
+}
+
+func (d *document) add(object []byte) int {
+	id := d.reserve()
+	d.set(id, object)
+	return id
+}
+
+func (d *document) addStream(dictionary string, data []byte) int {
+	var buf bytes.Buffer
+	fmt.Fprintf(&buf, "<< %s /Length %d >>\nstream\n", dictionary, len(data))
+	buf.Write(data)
+	buf.WriteString("\nendstream")
+
+	return d.add(buf.Bytes())
+}
+
+func (d *document) addImage(img image.Image) (int, error) {
+	bounds := img.Bounds()
+
+	var raw bytes.Buffer
+	zw := zlib.NewWriter(&raw)
+
+	row := make([]byte, bounds.Dx())
+	for y := bounds.Min.Y; y < bounds.Max.Y; y++ {
+		for x := bounds.Min.X; x < bounds.Max.X; x++ {
+			row[x-bounds.Min.X] = color.GrayModel.Convert(img.At(x, y)).(color.Gray).Y
+		}
+
+		_, err := zw.Write(row)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	err := zw.Close()
+	if err != nil {
+		return 0, err
+	}
+
+	return d.addStream(fmt.Sprintf("/Type /XObject /Subtype /Image /Width %d /Height %d /ColorSpace /DeviceGray /BitsPerComponent 8 /Filter /FlateDecode", bounds.Dx(), bounds.Dy()), raw.Bytes()), nil
+}
+
+// addAttachment embeds data as file name. The MIME type is written as PDF
+// name, so it must not contain characters other than letters, digits, '-',
+// '+' and '.' besides the '/'.
+func (d *document) addAttachment(name, mimeType string, data []byte) {
+	file := d.addStream(fmt.Sprintf("/Type /EmbeddedFile /Subtype /%s", strings.ReplaceAll(mimeType, "/", "#2F")), data)
+	spec := d.add([]byte(fmt.Sprintf("<< /Type /Filespec /F %s /UF %s /EF << /F %d 0 R >> >>", pdfString(name), pdfString(name), file)))
+
+	d.attachments = append(d.attachments, attachment{name: name, spec: spec})
+}
+
+// pdfString returns s as PDF literal string.
+func pdfString(s string) string {
+	r := strings.NewReplacer(`\`, `\\`, "(", `\(`, ")", `\)`)
+	return "(" + r.Replace(s) + ")"
+}
+
+// placement is an image drawn onto a page using the transformation matrix.
+type placement struct {
+	image  int
+	matrix [6]float64
+}
+
+func (d *document) addPage(width, height float64, placements []placement) {
+	var content, resources strings.Builder
+
+	for i, p := range placements {
+		m := p.matrix
+		fmt.Fprintf(&content, "q %s %s %s %s %s %s cm /Im%d Do Q\n", num(m[0]), num(m[1]), num(m[2]), num(m[3]), num(m[4]), num(m[5]), i)
+		fmt.Fprintf(&resources, "/Im%d %d 0 R ", i, p.image)
+	}
+
+	contentID := d.addStream("", []byte(content.String()))
+
+	page := d.reserve()
+	d.set(page, []byte(fmt.Sprintf("<< /Type /Page /Parent %%PAGES%% /MediaBox [0 0 %s %s] /Resources << /XObject << %s>> >> /Contents %d 0 R >>", num(width), num(height), resources.String(), contentID)))
+	d.pages = append(d.pages, page)
+}
+
+func (d *document) writeTo(w io.Writer) error {
+	pagesID := d.reserve()
+	catalogID := d.reserve()
+
+	kids := make([]string, len(d.pages))
+	for i, page := range d.pages {
+		kids[i] = fmt.Sprintf("%d 0 R", page)
+		d.objects[page-1] = bytes.Replace(d.objects[page-1], []byte("%PAGES%"), []byte(fmt.Sprintf("%d 0 R", pagesID)), 1)
+	}
+
+	d.set(pagesID, []byte(fmt.Sprintf("<< /Type /Pages /Kids [%s] /Count %d >>", strings.Join(kids, " "), len(d.pages))))
+	catalog := fmt.Sprintf("<< /Type /Catalog /Pages %d 0 R", pagesID)
+	if len(d.attachments) > 0 {
+		// The name tree requires the names in sorted order.
+		attachments := slices.Clone(d.attachments)
+		slices.SortFunc(attachments, func(a, b attachment) int {
+			return strings.Compare(a.name, b.name)
+		})
+
+		names := make([]string, len(attachments))
+		for i, a := range attachments {
+			names[i] = fmt.Sprintf("%s %d 0 R", pdfString(a.name), a.spec)
+		}
+
+		catalog += fmt.Sprintf(" /Names << /EmbeddedFiles << /Names [%s] >> >> /PageMode /UseAttachments", strings.Join(names, " "))
+	}
+	catalog += " >>"
+
+	d.set(catalogID, []byte(catalog))
+
+	bw := bufio.NewWriter(w)
+	cw := &countingWriter{w: bw}
+
+	fmt.Fprint(cw, "%PDF-1.4\n%\xe2\xe3\xcf\xd3\n")
+
+	offsets := make([]int64, len(d.objects))
+	for i, object := range d.objects {
+		offsets[i] = cw.n
+		fmt.Fprintf(cw, "%d 0 obj\n", i+1)
+		cw.Write(object)
+		fmt.Fprint(cw, "\nendobj\n")
+	}
+
+	xref := cw.n
+	fmt.Fprintf(cw, "xref\n0 %d\n0000000000 65535 f \n", len(d.objects)+1)
+	for _, offset := range offsets {
+		fmt.Fprintf(cw, "%010d 00000 n \n", offset)
+	}
+	fmt.Fprintf(cw, "trailer\n<< /Size %d /Root %d 0 R >>\nstartxref\n%d\n%%%%EOF\n", len(d.objects)+1, catalogID, xref)
+
+	if cw.err != nil {
+		return cw.err
+	}
+
+	return bw.Flush()
+}
+
+type countingWriter struct {
+	w   io.Writer
+	n   int64
+	err error
+}
+
+func (c *countingWriter) Write(p []byte) (int, error) {
+	if c.err != nil {
+		return 0, c.err
+	}
+
+	n, err := c.w.Write(p)
+	c.n += int64(n)
+	c.err = err
+
+	return n, err
+}
+
+func num(f float64) string {
+	s := fmt.Sprintf("%.3f", f)
+	s = strings.TrimRight(s, "0")
+	s = strings.TrimSuffix(s, ".")
+	if s == "-0" {
+		return "0"
+	}
+
+	return s
+}
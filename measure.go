@@ -0,0 +1,327 @@
+package ups
+
+import (
+	"errors"
+	"fmt"
+	"math"
+	"math/big"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ErrFieldOverflow is returned if a value does not fit into the length of
+// its field.
+var ErrFieldOverflow = errors.New("value does not fit into field")
+
+// Conversion factors between the English and metric units.
+const (
+	centimetersPerInch = 2.54
+	kilogramsPerPound  = 0.45359237
+	ouncesPerPound     = 16
+)
+
+// Field limits of the numeric string fields.
+const (
+	weightMaxLength      = 5
+	dimensionMaxLength   = 3
+	measurementDecimals  = 2
+	dimWeightLength      = 6
+	numOfPiecesMaxLength = 5
+	unitPriceMaxLength   = 12
+)
+
+// decimalValue returns v as the shortest decimal representing it, e.g. 1.005
+// instead of 1.00499999999999989..., which v is stored as.
+func decimalValue(v float64) *big.Rat {
+	r, _ := new(big.Rat).SetString(strconv.FormatFloat(v, 'f', -1, 64))
+	return r
+}
+
+// ceilScaled returns v times 10^decimals rounded up to an integer. v is
+// taken as its decimal value, so 1.2 is not rounded up to 1.21.
+func ceilScaled(v float64, decimals int) *big.Int {
+	r := decimalValue(v)
+	r.Mul(r, new(big.Rat).SetInt(pow10(decimals)))
+
+	n := new(big.Int).Quo(r.Num(), r.Denom())
+	if !r.IsInt() && r.Sign() > 0 {
+		n.Add(n, big.NewInt(1))
+	}
+
+	return n
+}
+
+func pow10(n int) *big.Int {
+	return new(big.Int).Exp(big.NewInt(10), big.NewInt(int64(n)), nil)
+}
+
+// roundUp rounds v up to decimals places.
+func roundUp(v float64, decimals int) float64 {
+	f, _ := new(big.Rat).SetFrac(ceilScaled(v, decimals), pow10(decimals)).Float64()
+	return f
+}
+
+// formatMeasurement formats v rounded up to at most decimals places, like UPS
+// rounds weights and dimensions when billing. Decimal places are dropped
+// until the value fits into maxLength.
+func formatMeasurement(v float64, maxLength, decimals int) (string, error) {
+	if math.IsNaN(v) || math.IsInf(v, 0) || v <= 0 {
+		return "", fmt.Errorf("%v is not a positive number", v)
+	}
+
+	for d := decimals; d >= 0; d-- {
+		s := strconv.FormatFloat(roundUp(v, d), 'f', -1, 64)
+		if len(s) <= maxLength {
+			return s, nil
+		}
+	}
+
+	return "", fmt.Errorf("%v exceeds %d characters: %w", v, maxLength, ErrFieldOverflow)
+}
+
+// poundsPer returns the number of pounds of one unit.
+func poundsPer(unit WeightUnit) (float64, error) {
+	switch WeightUnit(strings.ToUpper(string(unit))) {
+	case WeightUnitPounds:
+		return 1, nil
+	case WeightUnitKilograms:
+		return 1 / kilogramsPerPound, nil
+	case WeightUnitOunces:
+		return 1.0 / ouncesPerPound, nil
+	}
+
+	return 0, fmt.Errorf("unknown weight unit of measurement %q", unit)
+}
+
+// inchesPer returns the number of inches of one unit.
+func inchesPer(unit DimensionUnit) (float64, error) {
+	switch DimensionUnit(strings.ToUpper(string(unit))) {
+	case DimensionUnitInches, DimensionUnitEnglish:
+		return 1, nil
+	case DimensionUnitCentimeters, DimensionUnitMetric:
+		return 1 / centimetersPerInch, nil
+	}
+
+	return 0, fmt.Errorf("unknown dimensions unit of measurement %q", unit)
+}
+
+// ConvertWeight converts v from one weight unit to another.
+func ConvertWeight(v float64, from, to WeightUnit) (float64, error) {
+	fromPounds, err := poundsPer(from)
+	if err != nil {
+		return 0, err
+	}
+
+	toPounds, err := poundsPer(to)
+	if err != nil {
+		return 0, err
+	}
+
+	return v * fromPounds / toPounds, nil
+}
+
+// ConvertLength converts v from one dimension unit to another.
+func ConvertLength(v float64, from, to DimensionUnit) (float64, error) {
+	fromInches, err := inchesPer(from)
+	if err != nil {
+		return 0, err
+	}
+
+	toInches, err := inchesPer(to)
+	if err != nil {
+		return 0, err
+	}
+
+	return v * fromInches / toInches, nil
+}
+
+// WeightKG returns the package weight of kg kilograms.
+func WeightKG(kg float64) (*PackageWeight, error) {
+	return NewPackageWeight(kg, WeightUnitKilograms)
+}
+
+// WeightLBS returns the package weight of lbs pounds.
+func WeightLBS(lbs float64) (*PackageWeight, error) {
+	return NewPackageWeight(lbs, WeightUnitPounds)
+}
+
+// WeightOZS returns the package weight of oz ounces.
+func WeightOZS(oz float64) (*PackageWeight, error) {
+	return NewPackageWeight(oz, WeightUnitOunces)
+}
+
+// NewPackageWeight returns the package weight of v in unit. The weight is
+// rounded up to two decimal places, or less if the value would not fit into
+// the 5 characters of the field. ErrFieldOverflow is returned for weights
+// over 99999.
+func NewPackageWeight(v float64, unit WeightUnit) (*PackageWeight, error) {
+	if _, err := poundsPer(unit); err != nil {
+		return nil, err
+	}
+
+	weight, err := formatMeasurement(v, weightMaxLength, measurementDecimals)
+	if err != nil {
+		return nil, fmt.Errorf("weight: %w", err)
+	}
+
+	return &PackageWeight{
+		UnitOfMeasurement: PackageWeightUnitOfMeasurement{Code: string(unit)},
+		Weight:            weight,
+	}, nil
+}
+
+// ValueIn returns the weight converted to unit.
+func (w *PackageWeight) ValueIn(unit WeightUnit) (float64, error) {
+	v, err := strconv.ParseFloat(strings.TrimSpace(w.Weight), 64)
+	if err != nil {
+		return 0, fmt.Errorf("invalid weight %q", w.Weight)
+	}
+
+	return ConvertWeight(v, WeightUnit(w.UnitOfMeasurement.Code), unit)
+}
+
+// Convert returns the weight converted to unit.
+func (w *PackageWeight) Convert(unit WeightUnit) (*PackageWeight, error) {
+	v, err := w.ValueIn(unit)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewPackageWeight(v, unit)
+}
+
+// DimensionsCM returns the package dimensions in centimeters.
+func DimensionsCM(length, width, height float64) (Dimensions, error) {
+	return NewDimensions(length, width, height, DimensionUnitCentimeters)
+}
+
+// DimensionsIN returns the package dimensions in inches.
+func DimensionsIN(length, width, height float64) (Dimensions, error) {
+	return NewDimensions(length, width, height, DimensionUnitInches)
+}
+
+// NewDimensions returns the package dimensions in unit. The values are sorted,
+// so that Length is the longest side as required by UPS, and rounded up to two
+// decimal places, or less if the value would not fit into the 3 characters of
+// the fields. ErrFieldOverflow is returned for sides over 999.
+func NewDimensions(length, width, height float64, unit DimensionUnit) (Dimensions, error) {
+	if _, err := inchesPer(unit); err != nil {
+		return Dimensions{}, err
+	}
+
+	sides := []float64{length, width, height}
+	slices.Sort(sides)
+	slices.Reverse(sides)
+
+	formatted := make([]string, len(sides))
+	for i, side := range sides {
+		s, err := formatMeasurement(side, dimensionMaxLength, measurementDecimals)
+		if err != nil {
+			return Dimensions{}, fmt.Errorf("dimension: %w", err)
+		}
+
+		formatted[i] = s
+	}
+
+	return Dimensions{
+		UnitOfMeasurement: DimensionsUnitOfMeasurement{Code: string(unit)},
+		Length:            formatted[0],
+		Width:             formatted[1],
+		Height:            formatted[2],
+	}, nil
+}
+
+// ValuesIn returns length, width and height converted to unit.
+func (d *Dimensions) ValuesIn(unit DimensionUnit) (length, width, height float64, err error) {
+	values := make([]float64, 3)
+
+	for i, value := range []string{d.Length, d.Width, d.Height} {
+		v, err := strconv.ParseFloat(strings.TrimSpace(value), 64)
+		if err != nil {
+			return 0, 0, 0, fmt.Errorf("invalid dimension %q", value)
+		}
+
+		values[i], err = ConvertLength(v, DimensionUnit(d.UnitOfMeasurement.Code), unit)
+		if err != nil {
+			return 0, 0, 0, err
+		}
+	}
+
+	return values[0], values[1], values[2], nil
+}
+
+// Convert returns the dimensions converted to unit.
+func (d *Dimensions) Convert(unit DimensionUnit) (Dimensions, error) {
+	length, width, height, err := d.ValuesIn(unit)
+	if err != nil {
+		return Dimensions{}, err
+	}
+
+	return NewDimensions(length, width, height, unit)
+}
+
+// DimWeightKG returns the dimensional weight of kg kilograms.
+func DimWeightKG(kg float64) (*DimWeight, error) {
+	return NewDimWeight(kg, WeightUnitKilograms)
+}
+
+// DimWeightLBS returns the dimensional weight of lbs pounds.
+func DimWeightLBS(lbs float64) (*DimWeight, error) {
+	return NewDimWeight(lbs, WeightUnitPounds)
+}
+
+// NewDimWeight returns the dimensional weight of v in unit. The weight is
+// rounded up to one decimal place and formatted with the implied decimal
+// place UPS expects, e.g. 11.5 as 000115. ErrFieldOverflow is returned for
+// weights over 99999.9.
+func NewDimWeight(v float64, unit WeightUnit) (*DimWeight, error) {
+	if _, err := poundsPer(unit); err != nil {
+		return nil, err
+	}
+
+	if math.IsNaN(v) || math.IsInf(v, 0) || v <= 0 {
+		return nil, fmt.Errorf("dimensional weight: %v is not a positive number", v)
+	}
+
+	weight := fmt.Sprintf("%0*d", dimWeightLength, ceilScaled(v, 1))
+	if len(weight) > dimWeightLength {
+		return nil, fmt.Errorf("dimensional weight: %v exceeds %d characters: %w", v, dimWeightLength, ErrFieldOverflow)
+	}
+
+	return &DimWeight{
+		UnitOfMeasurement: DimWeightUnitOfMeasurement{Code: string(unit)},
+		Weight:            weight,
+	}, nil
+}
+
+// FormatNumOfPieces formats the number of pieces of Package.NumOfPieces.
+// ErrFieldOverflow is returned for more than 99999 pieces.
+func FormatNumOfPieces(n int) (string, error) {
+	if n < 1 {
+		return "", fmt.Errorf("number of pieces: %d is not a positive number", n)
+	}
+
+	s := strconv.Itoa(n)
+	if len(s) > numOfPiecesMaxLength {
+		return "", fmt.Errorf("number of pieces: %d exceeds %d characters: %w", n, numOfPiecesMaxLength, ErrFieldOverflow)
+	}
+
+	return s, nil
+}
+
+// FormatUnitPrice formats the price of Package.UnitPrice rounded half up to
+// two decimal places, e.g. 1.005 as 1.01. ErrFieldOverflow is returned for
+// prices over 999999999.99.
+func FormatUnitPrice(price float64) (string, error) {
+	if math.IsNaN(price) || math.IsInf(price, 0) || price < 0 {
+		return "", fmt.Errorf("unit price: %v is not a valid price", price)
+	}
+
+	s := decimalValue(price).FloatString(2)
+	if len(s) > unitPriceMaxLength {
+		return "", fmt.Errorf("unit price: %v exceeds %d characters: %w", price, unitPriceMaxLength, ErrFieldOverflow)
+	}
+
+	return s, nil
+}
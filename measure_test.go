@@ -0,0 +1,229 @@
+package ups
+
+import (
+	"errors"
+	"math"
+	"testing"
+)
+
+func TestNewPackageWeight(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   float64
+		unit    WeightUnit
+		want    string
+		wantErr error
+	}{
+		{"exact", 1.2, WeightUnitPounds, "1.2", nil},
+		{"two decimal places", 1.25, WeightUnitKilograms, "1.25", nil},
+		{"rounded up", 1.201, WeightUnitPounds, "1.21", nil},
+		{"fewer decimal places", 999.991, WeightUnitPounds, "1000", nil},
+		{"maximum", 99999, WeightUnitPounds, "99999", nil},
+		{"lower case unit", 3, "lbs", "3", nil},
+		{"overflow", 99999.5, WeightUnitPounds, "", ErrFieldOverflow},
+		{"zero", 0, WeightUnitPounds, "", errors.New("")},
+		{"NaN", math.NaN(), WeightUnitPounds, "", errors.New("")},
+		{"unknown unit", 1, "TON", "", errors.New("")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewPackageWeight(tt.value, tt.unit)
+			if (err != nil) != (tt.wantErr != nil) || errors.Is(tt.wantErr, ErrFieldOverflow) && !errors.Is(err, ErrFieldOverflow) {
+				t.Fatalf("NewPackageWeight() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if err == nil && (got.Weight != tt.want || got.UnitOfMeasurement.Code != string(tt.unit)) {
+				t.Errorf("NewPackageWeight() = %s %s, want %s %s", got.Weight, got.UnitOfMeasurement.Code, tt.want, tt.unit)
+			}
+		})
+	}
+}
+
+func TestNewDimensions(t *testing.T) {
+	tests := []struct {
+		name                  string
+		length, width, height float64
+		unit                  DimensionUnit
+		want                  [3]string
+		wantErr               error
+	}{
+		{"sorted", 10, 30, 20, DimensionUnitInches, [3]string{"30", "20", "10"}, nil},
+		{"decimal places", 1.2, 2.25, 3.333, DimensionUnitInches, [3]string{"3.4", "2.3", "1.2"}, nil},
+		{"rounded up to fit", 12.345, 10, 5, DimensionUnitCentimeters, [3]string{"13", "10", "5"}, nil},
+		{"maximum", 999, 1, 1, DimensionUnitCentimeters, [3]string{"999", "1", "1"}, nil},
+		{"overflow", 999.5, 1, 1, DimensionUnitCentimeters, [3]string{}, ErrFieldOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewDimensions(tt.length, tt.width, tt.height, tt.unit)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewDimensions() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if err == nil && [3]string{got.Length, got.Width, got.Height} != tt.want {
+				t.Errorf("NewDimensions() = %s x %s x %s, want %v", got.Length, got.Width, got.Height, tt.want)
+			}
+		})
+	}
+}
+
+func TestNewDimWeight(t *testing.T) {
+	tests := []struct {
+		name    string
+		value   float64
+		want    string
+		wantErr error
+	}{
+		{"implied decimal place", 11.5, "000115", nil},
+		{"rounded up", 11.41, "000115", nil},
+		{"exact tenths", 0.3, "000003", nil},
+		{"maximum", 99999.9, "999999", nil},
+		{"overflow", 99999.91, "", ErrFieldOverflow},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewDimWeight(tt.value, WeightUnitPounds)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("NewDimWeight() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if err == nil && got.Weight != tt.want {
+				t.Errorf("NewDimWeight() = %s, want %s", got.Weight, tt.want)
+			}
+		})
+	}
+}
+
+func TestFormatUnitPrice(t *testing.T) {
+	tests := []struct {
+		price   float64
+		want    string
+		wantErr error
+	}{
+		{1.005, "1.01", nil},
+		{1.004, "1.00", nil},
+		{0.125, "0.13", nil},
+		{19.99, "19.99", nil},
+		{0, "0.00", nil},
+		{999999999.99, "999999999.99", nil},
+		{1e9, "", ErrFieldOverflow},
+	}
+
+	for _, tt := range tests {
+		got, err := FormatUnitPrice(tt.price)
+		if !errors.Is(err, tt.wantErr) {
+			t.Errorf("FormatUnitPrice(%v) error = %v, want %v", tt.price, err, tt.wantErr)
+			continue
+		}
+
+		if got != tt.want {
+			t.Errorf("FormatUnitPrice(%v) = %s, want %s", tt.price, got, tt.want)
+		}
+	}
+
+	if _, err := FormatUnitPrice(-1); err == nil {
+		t.Error("FormatUnitPrice(-1) error = nil")
+	}
+}
+
+func TestFormatNumOfPieces(t *testing.T) {
+	tests := []struct {
+		n       int
+		want    string
+		wantErr bool
+	}{
+		{1, "1", false},
+		{99999, "99999", false},
+		{100000, "", true},
+		{0, "", true},
+	}
+
+	for _, tt := range tests {
+		got, err := FormatNumOfPieces(tt.n)
+		if (err != nil) != tt.wantErr || got != tt.want {
+			t.Errorf("FormatNumOfPieces(%d) = %q, %v, want %q", tt.n, got, err, tt.want)
+		}
+	}
+}
+
+func TestConvertWeight(t *testing.T) {
+	tests := []struct {
+		value    float64
+		from, to WeightUnit
+		want     float64
+	}{
+		{1, WeightUnitPounds, WeightUnitKilograms, 0.45359237},
+		{1, WeightUnitKilograms, WeightUnitPounds, 2.2046226218},
+		{32, WeightUnitOunces, WeightUnitPounds, 2},
+		{1, WeightUnitKilograms, WeightUnitOunces, 35.2739619496},
+		{5, "kgs", "KGS", 5},
+	}
+
+	for _, tt := range tests {
+		got, err := ConvertWeight(tt.value, tt.from, tt.to)
+		if err != nil || math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("ConvertWeight(%v, %s, %s) = %v, %v, want %v", tt.value, tt.from, tt.to, got, err, tt.want)
+		}
+	}
+
+	if _, err := ConvertWeight(1, WeightUnitPounds, "G"); err == nil {
+		t.Error("ConvertWeight() to unknown unit error = nil")
+	}
+}
+
+func TestConvertLength(t *testing.T) {
+	tests := []struct {
+		value    float64
+		from, to DimensionUnit
+		want     float64
+	}{
+		{1, DimensionUnitInches, DimensionUnitCentimeters, 2.54},
+		{25.4, DimensionUnitCentimeters, DimensionUnitInches, 10},
+		{10, DimensionUnitEnglish, DimensionUnitMetric, 25.4},
+		{10, DimensionUnitCentimeters, DimensionUnitMetric, 10},
+	}
+
+	for _, tt := range tests {
+		got, err := ConvertLength(tt.value, tt.from, tt.to)
+		if err != nil || math.Abs(got-tt.want) > 1e-9 {
+			t.Errorf("ConvertLength(%v, %s, %s) = %v, %v, want %v", tt.value, tt.from, tt.to, got, err, tt.want)
+		}
+	}
+
+	if _, err := ConvertLength(1, "MM", DimensionUnitInches); err == nil {
+		t.Error("ConvertLength() from unknown unit error = nil")
+	}
+}
+
+func TestConvertPackageWeightAndDimensions(t *testing.T) {
+	weight, err := WeightKG(10)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	pounds, err := weight.Convert(WeightUnitPounds)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if pounds.Weight != "22.05" || WeightUnit(pounds.UnitOfMeasurement.Code) != WeightUnitPounds {
+		t.Errorf("Convert() = %s %s, want 22.05 LBS", pounds.Weight, pounds.UnitOfMeasurement.Code)
+	}
+
+	dimensions, err := DimensionsIN(10, 5, 1.2)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	centimeters, err := dimensions.Convert(DimensionUnitCentimeters)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if centimeters.Length != "26" || centimeters.Width != "13" || centimeters.Height != "3.1" {
+		t.Errorf("Convert() = %s x %s x %s, want 26 x 13 x 3.1", centimeters.Length, centimeters.Width, centimeters.Height)
+	}
+}
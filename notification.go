@@ -0,0 +1,224 @@
+package ups
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// NotificationCode is the Quantum View notification type of
+// Notification.NotificationCode.
+type NotificationCode string
+
+// Quantum View notification codes of Notification.NotificationCode. The
+// field is a string, so the constants are assigned with
+// string(NotificationCodeShip).
+const (
+	NotificationCodeReturnOrLabelCreation NotificationCode = "2"
+	NotificationCodeInTransit             NotificationCode = "5"
+	NotificationCodeShip                  NotificationCode = "6"
+	NotificationCodeException             NotificationCode = "7"
+	NotificationCodeDelivery              NotificationCode = "8"
+
+	NotificationCodeAlternateDeliveryLocation NotificationCode = "012"
+	NotificationCodeUAPShipper                NotificationCode = "013"
+)
+
+// NotificationInfo describes a Quantum View notification type.
+type NotificationInfo struct {
+	Code NotificationCode
+	// Name to display.
+	Name string
+	// Forward is true if the notification is available for forward
+	// shipments. Return and in-transit notifications are only available for
+	// forward shipments with import control.
+	Forward bool
+	// ReturnEligible is true if the notification is available for return
+	// shipments.
+	ReturnEligible bool
+	// AccessPoint is true for the notifications of UPS Access Point
+	// shipments, which also support voice and text messages.
+	AccessPoint bool
+}
+
+// notifications contains all notification types of
+// Notification.NotificationCode.
+var notifications = registry[NotificationInfo]{
+	{NotificationCodeReturnOrLabelCreation, "Return or Label Creation Notification", false, true, false},
+	{NotificationCodeInTransit, "In-transit Notification", false, true, false},
+	{NotificationCodeShip, "Ship Notification", true, false, false},
+	{NotificationCodeException, "Exception Notification", true, true, false},
+	{NotificationCodeDelivery, "Delivery Notification", true, true, false},
+	{NotificationCodeAlternateDeliveryLocation, "Alternate Delivery Location Notification", true, false, true},
+	{NotificationCodeUAPShipper, "UAP Shipper Notification", true, false, true},
+}
+
+func (n NotificationInfo) code() string            { return string(n.Code) }
+func (n NotificationInfo) name() string            { return n.Name }
+func (n NotificationInfo) returnEligible() bool    { return n.ReturnEligible }
+func (n NotificationInfo) clone() NotificationInfo { return n }
+
+// Notifications returns all known notification types.
+func Notifications() []NotificationInfo {
+	return notifications.all()
+}
+
+// Info returns the description of the notification type. ok is false for
+// unknown notification types.
+func (c NotificationCode) Info() (info NotificationInfo, ok bool) {
+	return notifications.info(string(c))
+}
+
+// IsValid reports whether the notification type is known.
+func (c NotificationCode) IsValid() bool {
+	return notifications.isValid(string(c))
+}
+
+// Name returns the display name of the notification type or the code if it
+// is unknown.
+func (c NotificationCode) Name() string {
+	return notifications.name(string(c))
+}
+
+// IsReturnEligible reports whether the notification type is available for
+// return shipments.
+func (c NotificationCode) IsReturnEligible() bool {
+	return isReturnEligible(notifications, string(c))
+}
+
+// isZero reports whether no field of the e-mail is set.
+func (e *EMail) isZero() bool {
+	return len(e.EMailAddresses) == 0 && e.UndeliverableEMailAddress == "" && e.FromEMailAddress == "" &&
+		e.FromName == "" && e.Memo == ""
+}
+
+// MarshalJSON omits the EMail container if it is empty.
+func (n Notification) MarshalJSON() ([]byte, error) {
+	type notification Notification
+
+	v := struct {
+		notification
+		EMail *EMail `json:",omitempty"`
+	}{
+		notification: notification(n),
+	}
+
+	if !n.EMail.isZero() {
+		v.EMail = &n.EMail
+	}
+
+	return json.Marshal(v)
+}
+
+// maxNotifications is the maximum number of shipment level notifications.
+const maxNotifications = 3
+
+// isAccessPointNotification reports whether code is one of the notification
+// codes of UPS Access Point shipments, which also support voice and text
+// messages.
+func isAccessPointNotification(code string) bool {
+	info, ok := NotificationCode(code).Info()
+	return ok && info.AccessPoint
+}
+
+func (s *Shipment) validateNotifications() []error {
+	options := s.ShipmentServiceOptions
+	if options == nil {
+		return nil
+	}
+
+	var errs []error
+
+	if len(options.Notifications) > maxNotifications {
+		errs = append(errs, newValidationError("Shipment.ShipmentServiceOptions.Notification", "up to %d notifications are allowed, got %d", maxNotifications, len(options.Notifications)))
+	}
+
+	returnOrImport := s.IsReturn() || options.ImportControlIndicator != ""
+
+	for i, n := range options.Notifications {
+		field := fmt.Sprintf("Shipment.ShipmentServiceOptions.Notification[%d]", i)
+
+		switch NotificationCode(n.NotificationCode) {
+		case NotificationCodeReturnOrLabelCreation, NotificationCodeInTransit:
+			if !returnOrImport {
+				errs = append(errs, newValidationError(field+".NotificationCode", "%s is only valid for return and import control shipments", n.NotificationCode))
+			}
+		case NotificationCodeShip:
+			if s.IsReturn() {
+				errs = append(errs, newValidationError(field+".NotificationCode", "%s is only valid for forward shipments", n.NotificationCode))
+			}
+		case NotificationCodeException, NotificationCodeDelivery, NotificationCodeAlternateDeliveryLocation, NotificationCodeUAPShipper:
+		default:
+			errs = append(errs, newValidationError(field+".NotificationCode", "%q is not a valid notification code", n.NotificationCode))
+		}
+
+		if n.EMail.isZero() && n.VoiceMessage == nil && n.TextMessage == nil {
+			errs = append(errs, newValidationError(field, "an e-mail, voice or text message is required"))
+		}
+
+		if !n.EMail.isZero() && (len(n.EMail.EMailAddresses) == 0 || len(n.EMail.EMailAddresses) > 5) {
+			errs = append(errs, newValidationError(field+".EMail.EMailAddress", "1 to 5 addresses are required, got %d", len(n.EMail.EMailAddresses)))
+		}
+
+		if !isAccessPointNotification(n.NotificationCode) {
+			if n.VoiceMessage != nil {
+				errs = append(errs, newValidationError(field+".VoiceMessage", "not valid for notification code %s", n.NotificationCode))
+			}
+
+			if n.TextMessage != nil {
+				errs = append(errs, newValidationError(field+".TextMessage", "not valid for notification code %s", n.NotificationCode))
+			}
+		}
+
+		if n.Locale == nil && (isAccessPointNotification(n.NotificationCode) || n.VoiceMessage != nil || n.TextMessage != nil) {
+			errs = append(errs, newValidationError(field+".Locale", "is required for notification code %s and voice or text messages", n.NotificationCode))
+		}
+
+		errs = append(errs, validateNotificationChannels(field, n.VoiceMessage, n.TextMessage, n.Locale)...)
+	}
+
+	if len(options.PreAlertNotifications) > 0 && s.originCountryCode() == s.destinationCountryCode() {
+		errs = append(errs, newValidationError("Shipment.ShipmentServiceOptions.PreAlertNotification", "only valid for international shipments"))
+	}
+
+	for i, n := range options.PreAlertNotifications {
+		field := fmt.Sprintf("Shipment.ShipmentServiceOptions.PreAlertNotification[%d]", i)
+
+		if n.EMailMessage == nil && n.VoiceMessage == nil && n.TextMessage == nil {
+			errs = append(errs, newValidationError(field, "an e-mail, voice or text message is required"))
+		}
+
+		errs = append(errs, validateNotificationChannels(field, n.VoiceMessage, n.TextMessage, &n.Locale)...)
+	}
+
+	return errs
+}
+
+func validateNotificationChannels(field string, voice, text *NotificationPhone, locale *Locale) []error {
+	var errs []error
+
+	if voice != nil && !isPhoneNumber(voice.PhoneNumber) {
+		errs = append(errs, newValidationError(field+".VoiceMessage.PhoneNumber", "%q is not a valid phone number", voice.PhoneNumber))
+	}
+
+	if text != nil && !isPhoneNumber(text.PhoneNumber) {
+		errs = append(errs, newValidationError(field+".TextMessage.PhoneNumber", "%q is not a valid phone number", text.PhoneNumber))
+	}
+
+	if locale != nil {
+		if len(locale.Language) != 3 {
+			errs = append(errs, newValidationError(field+".Locale.Language", "%q is not a 3 letter language code", locale.Language))
+		}
+
+		if len(locale.Dialect) != 2 {
+			errs = append(errs, newValidationError(field+".Locale.Dialect", "%q is not a 2 character dialect code", locale.Dialect))
+		}
+	}
+
+	return errs
+}
+
+// isPhoneNumber reports whether number consists of 1 to 15 digits.
+func isPhoneNumber(number string) bool {
+	return len(number) > 0 && len(number) <= 15 && strings.Trim(number, "0123456789") == ""
+}
@@ -0,0 +1,184 @@
+package ups
+
+import (
+	"encoding/json"
+	"errors"
+	"strings"
+	"testing"
+)
+
+func TestValidateNotifications(t *testing.T) {
+	email := EMail{EMailAddresses: []string{"receiver@example.com"}}
+	locale := &Locale{Language: "ENG", Dialect: "US"}
+	phone := &NotificationPhone{PhoneNumber: "15555550100"}
+
+	tests := []struct {
+		name          string
+		destination   string
+		returns       bool
+		notifications []Notification
+		preAlerts     []PreAlertNotification
+		want          []string
+	}{
+		{
+			name:          "e-mail",
+			notifications: []Notification{{NotificationCode: string(NotificationCodeShip), EMail: email}},
+		},
+		{
+			name:          "no message",
+			notifications: []Notification{{NotificationCode: string(NotificationCodeDelivery)}},
+			want:          []string{"Shipment.ShipmentServiceOptions.Notification[0]"},
+		},
+		{
+			name:          "e-mail without address",
+			notifications: []Notification{{NotificationCode: string(NotificationCodeDelivery), EMail: EMail{FromName: "Shipper"}}},
+			want:          []string{"Shipment.ShipmentServiceOptions.Notification[0].EMail.EMailAddress"},
+		},
+		{
+			name: "too many addresses",
+			notifications: []Notification{{NotificationCode: string(NotificationCodeDelivery), EMail: EMail{
+				EMailAddresses: []string{"a@example.com", "b@example.com", "c@example.com", "d@example.com", "e@example.com", "f@example.com"},
+			}}},
+			want: []string{"Shipment.ShipmentServiceOptions.Notification[0].EMail.EMailAddress"},
+		},
+		{
+			name: "too many notifications",
+			notifications: []Notification{
+				{NotificationCode: string(NotificationCodeShip), EMail: email},
+				{NotificationCode: string(NotificationCodeException), EMail: email},
+				{NotificationCode: string(NotificationCodeDelivery), EMail: email},
+				{NotificationCode: string(NotificationCodeDelivery), EMail: email},
+			},
+			want: []string{"Shipment.ShipmentServiceOptions.Notification"},
+		},
+		{
+			name:          "unknown code",
+			notifications: []Notification{{NotificationCode: "9", EMail: email}},
+			want:          []string{"Shipment.ShipmentServiceOptions.Notification[0].NotificationCode"},
+		},
+		{
+			name:          "in-transit for forward shipment",
+			notifications: []Notification{{NotificationCode: string(NotificationCodeInTransit), EMail: email}},
+			want:          []string{"Shipment.ShipmentServiceOptions.Notification[0].NotificationCode"},
+		},
+		{
+			name:          "in-transit for return shipment",
+			returns:       true,
+			notifications: []Notification{{NotificationCode: string(NotificationCodeInTransit), EMail: email}},
+		},
+		{
+			name:          "ship for return shipment",
+			returns:       true,
+			notifications: []Notification{{NotificationCode: string(NotificationCodeShip), EMail: email}},
+			want:          []string{"Shipment.ShipmentServiceOptions.Notification[0].NotificationCode"},
+		},
+		{
+			name:          "access point voice and text message",
+			notifications: []Notification{{NotificationCode: string(NotificationCodeUAPShipper), VoiceMessage: phone, TextMessage: phone, Locale: locale}},
+		},
+		{
+			name:          "access point without locale",
+			notifications: []Notification{{NotificationCode: string(NotificationCodeAlternateDeliveryLocation), EMail: email}},
+			want:          []string{"Shipment.ShipmentServiceOptions.Notification[0].Locale"},
+		},
+		{
+			name:          "text message for delivery notification",
+			notifications: []Notification{{NotificationCode: string(NotificationCodeDelivery), TextMessage: phone, Locale: locale}},
+			want:          []string{"Shipment.ShipmentServiceOptions.Notification[0].TextMessage"},
+		},
+		{
+			name: "invalid phone number and locale",
+			notifications: []Notification{{
+				NotificationCode: string(NotificationCodeUAPShipper),
+				VoiceMessage:     &NotificationPhone{PhoneNumber: "+1 555 0100"},
+				Locale:           &Locale{Language: "EN", Dialect: "USA"},
+			}},
+			want: []string{
+				"Shipment.ShipmentServiceOptions.Notification[0].VoiceMessage.PhoneNumber",
+				"Shipment.ShipmentServiceOptions.Notification[0].Locale.Language",
+				"Shipment.ShipmentServiceOptions.Notification[0].Locale.Dialect",
+			},
+		},
+		{
+			name:        "pre-alert",
+			destination: "CA",
+			preAlerts:   []PreAlertNotification{{EMailMessage: &PreAlertEMailMessage{EMailAddress: "receiver@example.com"}, Locale: *locale}},
+		},
+		{
+			name:      "domestic pre-alert",
+			preAlerts: []PreAlertNotification{{TextMessage: phone, Locale: *locale}},
+			want:      []string{"Shipment.ShipmentServiceOptions.PreAlertNotification"},
+		},
+		{
+			name:        "pre-alert without message",
+			destination: "CA",
+			preAlerts:   []PreAlertNotification{{Locale: *locale}},
+			want:        []string{"Shipment.ShipmentServiceOptions.PreAlertNotification[0]"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			destination := "US"
+			if tt.destination != "" {
+				destination = tt.destination
+			}
+
+			s := testShipment("US", destination)
+			s.ShipmentServiceOptions = &ShipmentServiceOptions{
+				Notifications:         tt.notifications,
+				PreAlertNotifications: tt.preAlerts,
+			}
+
+			if tt.returns {
+				s.ReturnService = &ReturnService{Code: ReturnServiceThreeAttempt}
+			}
+
+			checkValidationFields(t, errors.Join(s.validateNotifications()...), tt.want)
+		})
+	}
+}
+
+func TestNotificationJSON(t *testing.T) {
+	tests := []struct {
+		name         string
+		notification Notification
+		wantEMail    bool
+	}{
+		{
+			name:         "e-mail",
+			notification: Notification{NotificationCode: string(NotificationCodeShip), EMail: EMail{EMailAddresses: []string{"receiver@example.com"}}},
+			wantEMail:    true,
+		},
+		{
+			name: "text message only",
+			notification: Notification{
+				NotificationCode: string(NotificationCodeUAPShipper),
+				TextMessage:      &NotificationPhone{PhoneNumber: "15555550100"},
+				Locale:           &Locale{Language: "ENG", Dialect: "US"},
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.notification)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := strings.Contains(string(data), `"EMail"`); got != tt.wantEMail {
+				t.Errorf("json.Marshal() = %s, want EMail %t", data, tt.wantEMail)
+			}
+
+			var got Notification
+			if err := json.Unmarshal(data, &got); err != nil {
+				t.Fatal(err)
+			}
+
+			if got.NotificationCode != tt.notification.NotificationCode || len(got.EMail.EMailAddresses) != len(tt.notification.EMail.EMailAddresses) {
+				t.Errorf("json.Unmarshal() = %+v, want %+v", got, tt.notification)
+			}
+		})
+	}
+}
@@ -0,0 +1,89 @@
+package ups
+
+import "fmt"
+
+// Delivery confirmation types of DeliveryConfirmation.DCISType on package
+// level.
+const (
+	DeliveryConfirmationSignatureRequired      = "2"
+	DeliveryConfirmationAdultSignatureRequired = "3"
+)
+
+// Declared value types of DeclaredValueType.Code.
+const (
+	DeclaredValueTypeEVS = "01"
+	DeclaredValueTypeDVS = "02"
+)
+
+func (s *Shipment) validatePackageServiceOptions() []error {
+	var errs []error
+
+	origin, destination := s.originCountryCode(), s.destinationCountryCode()
+
+	for i, p := range s.Packages {
+		options := p.PackageServiceOptions
+		if options == nil {
+			continue
+		}
+
+		field := fmt.Sprintf("Shipment.Package[%d].PackageServiceOptions", i)
+
+		if c := options.DeliveryConfirmation; c != nil {
+			if c.DCISType != DeliveryConfirmationSignatureRequired && c.DCISType != DeliveryConfirmationAdultSignatureRequired {
+				errs = append(errs, newValidationError(field+".DeliveryConfirmation.DCISType", "%q is not valid for packages", c.DCISType))
+			}
+		}
+
+		if v := options.DeclaredValue; v != nil {
+			if v.Type != nil && v.Type.Code != DeclaredValueTypeEVS && v.Type.Code != DeclaredValueTypeDVS {
+				errs = append(errs, newValidationError(field+".DeclaredValue.Type.Code", "%q is not a valid declared value type", v.Type.Code))
+			}
+
+			if err := validateCurrencyCode(field+".DeclaredValue.CurrencyCode", v.CurrencyCode); err != nil {
+				errs = append(errs, err)
+			}
+
+			if err := validateMonetaryValue(field+".DeclaredValue.MonetaryValue", v.MonetaryValue); err != nil {
+				errs = append(errs, err)
+			}
+		}
+
+		if d := options.DryIce; d != nil {
+			if d.RegulationSet != "CFR" && d.RegulationSet != "IATA" {
+				errs = append(errs, newValidationError(field+".DryIce.RegulationSet", "%q is not valid, use CFR or IATA", d.RegulationSet))
+			}
+
+			switch d.DryIceWeight.UnitOfMeasurement.Code {
+			case "00", "01", "KGS", "LBS":
+			default:
+				errs = append(errs, newValidationError(field+".DryIce.DryIceWeight.UnitOfMeasurement.Code", "%q is not a valid unit", d.DryIceWeight.UnitOfMeasurement.Code))
+			}
+
+			if err := validateDecimal(field+".DryIce.DryIceWeight.Weight", d.DryIceWeight.Weight, 1); err != nil {
+				errs = append(errs, err)
+			}
+
+			if d.MedicalUseIndicator != "" && d.RegulationSet != "CFR" {
+				errs = append(errs, newValidationError(field+".DryIce.MedicalUseIndicator", "only valid with regulation set CFR"))
+			}
+		}
+
+		if v := options.VerbalConfirmation; v != nil {
+			if v.ContactInfo.Name == "" || v.ContactInfo.Phone == nil {
+				errs = append(errs, newValidationError(field+".VerbalConfirmation.ContactInfo", "name and phone are required"))
+			}
+		}
+
+		if options.ShipperReleaseIndicator != "" {
+			if (origin != "US" && origin != "PR") || (destination != "US" && destination != "PR") {
+				errs = append(errs, newValidationError(field+".ShipperReleaseIndicator", "only valid from US/PR to US/PR"))
+			}
+		}
+
+		if options.UPSPremiumCareIndicator != "" && (origin != "CA" || destination != "CA") {
+			errs = append(errs, newValidationError(field+".UPSPremiumCareIndicator", "only valid from CA to CA"))
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,106 @@
+package ups
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestValidatePackageServiceOptions(t *testing.T) {
+	tests := []struct {
+		name                string
+		origin, destination string
+		options             PackageServiceOptions
+		want                []string
+	}{
+		{
+			name: "valid",
+			options: PackageServiceOptions{
+				DeliveryConfirmation: &DeliveryConfirmation{DCISType: DeliveryConfirmationAdultSignatureRequired},
+				DeclaredValue:        &DeclaredValue{Type: &DeclaredValueType{Code: DeclaredValueTypeEVS}, CurrencyCode: "USD", MonetaryValue: "250.50"},
+				DryIce:               &DryIce{RegulationSet: "CFR", DryIceWeight: DryIceWeight{UnitOfMeasurement: DryIceWeightUnitOfMeasurement{Code: "LBS"}, Weight: "5.5"}},
+				VerbalConfirmation:   &VerbalConfirmation{ContactInfo: ContactInfo{Name: "Receiver", Phone: &Phone{Number: "5555550100"}}},
+			},
+		},
+		{
+			name:    "shipment level delivery confirmation type",
+			options: PackageServiceOptions{DeliveryConfirmation: &DeliveryConfirmation{DCISType: "1"}},
+			want:    []string{"Shipment.Package[0].PackageServiceOptions.DeliveryConfirmation.DCISType"},
+		},
+		{
+			name:    "invalid declared value",
+			options: PackageServiceOptions{DeclaredValue: &DeclaredValue{Type: &DeclaredValueType{Code: "03"}, CurrencyCode: "US", MonetaryValue: "0"}},
+			want: []string{
+				"Shipment.Package[0].PackageServiceOptions.DeclaredValue.Type.Code",
+				"Shipment.Package[0].PackageServiceOptions.DeclaredValue.CurrencyCode",
+				"Shipment.Package[0].PackageServiceOptions.DeclaredValue.MonetaryValue",
+			},
+		},
+		{
+			name:    "declared value without type",
+			options: PackageServiceOptions{DeclaredValue: &DeclaredValue{CurrencyCode: "USD", MonetaryValue: "100"}},
+		},
+		{
+			name:    "invalid dry ice",
+			options: PackageServiceOptions{DryIce: &DryIce{RegulationSet: "ADR", DryIceWeight: DryIceWeight{UnitOfMeasurement: DryIceWeightUnitOfMeasurement{Code: "OZS"}, Weight: "1.25"}}},
+			want: []string{
+				"Shipment.Package[0].PackageServiceOptions.DryIce.RegulationSet",
+				"Shipment.Package[0].PackageServiceOptions.DryIce.DryIceWeight.UnitOfMeasurement.Code",
+				"Shipment.Package[0].PackageServiceOptions.DryIce.DryIceWeight.Weight",
+			},
+		},
+		{
+			name: "medical use with IATA",
+			options: PackageServiceOptions{DryIce: &DryIce{
+				RegulationSet:       "IATA",
+				DryIceWeight:        DryIceWeight{UnitOfMeasurement: DryIceWeightUnitOfMeasurement{Code: "KGS"}, Weight: "2"},
+				MedicalUseIndicator: " ",
+			}},
+			want: []string{"Shipment.Package[0].PackageServiceOptions.DryIce.MedicalUseIndicator"},
+		},
+		{
+			name:    "verbal confirmation without phone",
+			options: PackageServiceOptions{VerbalConfirmation: &VerbalConfirmation{ContactInfo: ContactInfo{Name: "Receiver"}}},
+			want:    []string{"Shipment.Package[0].PackageServiceOptions.VerbalConfirmation.ContactInfo"},
+		},
+		{
+			name:        "shipper release from US to PR",
+			destination: "PR",
+			options:     PackageServiceOptions{ShipperReleaseIndicator: " "},
+		},
+		{
+			name:        "shipper release to CA",
+			destination: "CA",
+			options:     PackageServiceOptions{ShipperReleaseIndicator: " "},
+			want:        []string{"Shipment.Package[0].PackageServiceOptions.ShipperReleaseIndicator"},
+		},
+		{
+			name:        "premium care within CA",
+			origin:      "CA",
+			destination: "CA",
+			options:     PackageServiceOptions{UPSPremiumCareIndicator: " "},
+		},
+		{
+			name:    "premium care within the US",
+			options: PackageServiceOptions{UPSPremiumCareIndicator: " "},
+			want:    []string{"Shipment.Package[0].PackageServiceOptions.UPSPremiumCareIndicator"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			origin, destination := "US", "US"
+			if tt.origin != "" {
+				origin = tt.origin
+			}
+
+			if tt.destination != "" {
+				destination = tt.destination
+			}
+
+			s := testShipment(origin, destination)
+			s.Packages = []Package{{PackageServiceOptions: &tt.options}}
+
+			checkValidationFields(t, errors.Join(s.validatePackageServiceOptions()...), tt.want)
+		})
+	}
+}
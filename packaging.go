@@ -0,0 +1,124 @@
+package ups
+
+import "slices"
+
+// PackagingCode is the package type of Packaging.Code.
+type PackagingCode string
+
+// Package types of Packaging.Code. Packaging.Code is a string, so the
+// constants are assigned with string(PackagingPAK).
+const (
+	PackagingUPSLetter        PackagingCode = "01"
+	PackagingCustomerSupplied PackagingCode = "02"
+	PackagingTube             PackagingCode = "03"
+	PackagingPAK              PackagingCode = "04"
+	PackagingUPSExpressBox    PackagingCode = "21"
+	PackagingUPS25KGBox       PackagingCode = "24"
+	PackagingUPS10KGBox       PackagingCode = "25"
+	PackagingPallet           PackagingCode = "30"
+	PackagingSmallExpressBox  PackagingCode = "2a"
+	PackagingMediumExpressBox PackagingCode = "2b"
+	PackagingLargeExpressBox  PackagingCode = "2c"
+	PackagingFlats            PackagingCode = "56"
+	PackagingParcels          PackagingCode = "57"
+	PackagingBPM              PackagingCode = "58"
+	PackagingFirstClass       PackagingCode = "59"
+	PackagingPriority         PackagingCode = "60"
+	PackagingMachineables     PackagingCode = "61"
+	PackagingIrregulars       PackagingCode = "62"
+	PackagingParcelPost       PackagingCode = "63"
+	PackagingBPMParcel        PackagingCode = "64"
+	PackagingMediaMail        PackagingCode = "65"
+	PackagingBPMFlat          PackagingCode = "66"
+	PackagingStandardFlat     PackagingCode = "67"
+)
+
+// PackagingInfo describes a UPS package type.
+type PackagingInfo struct {
+	Code PackagingCode
+	// Name to display, e.g. in a packaging picker.
+	Name string
+	// Domestic is true if the package type is available within a country.
+	Domestic bool
+	// International is true if the package type is available across
+	// borders.
+	International bool
+	// ReturnEligible is true if the package type is available for return
+	// shipments.
+	ReturnEligible bool
+	// OriginCountries contains the countries or territories the package
+	// type is available from. It is empty if it is available worldwide.
+	OriginCountries []string
+}
+
+// packagings contains all package types of Packaging.Code. The Mail
+// Innovations package types are only available from the US.
+var packagings = registry[PackagingInfo]{
+	{PackagingUPSLetter, "UPS Letter", true, true, true, nil},
+	{PackagingCustomerSupplied, "Customer Supplied Package", true, true, true, nil},
+	{PackagingTube, "Tube", true, true, true, nil},
+	{PackagingPAK, "PAK", true, true, true, nil},
+	{PackagingUPSExpressBox, "UPS Express Box", true, true, true, nil},
+	{PackagingUPS25KGBox, "UPS 25KG Box", false, true, false, nil},
+	{PackagingUPS10KGBox, "UPS 10KG Box", false, true, false, nil},
+	{PackagingPallet, "Pallet", true, true, true, nil},
+	{PackagingSmallExpressBox, "Small Express Box", true, true, true, nil},
+	{PackagingMediumExpressBox, "Medium Express Box", true, true, true, nil},
+	{PackagingLargeExpressBox, "Large Express Box", true, true, true, nil},
+	{PackagingFlats, "Flats", true, true, true, usOrigins},
+	{PackagingParcels, "Parcels", true, true, true, usOrigins},
+	{PackagingBPM, "BPM", true, true, true, usOrigins},
+	{PackagingFirstClass, "First Class", true, true, true, usOrigins},
+	{PackagingPriority, "Priority", true, true, true, usOrigins},
+	{PackagingMachineables, "Machineables", true, false, true, usOrigins},
+	{PackagingIrregulars, "Irregulars", true, false, true, usOrigins},
+	{PackagingParcelPost, "Parcel Post", true, false, true, usOrigins},
+	{PackagingBPMParcel, "BPM Parcel", true, false, true, usOrigins},
+	{PackagingMediaMail, "Media Mail", true, false, true, usOrigins},
+	{PackagingBPMFlat, "BPM Flat", true, false, true, usOrigins},
+	{PackagingStandardFlat, "Standard Flat", true, false, true, usOrigins},
+}
+
+func (p PackagingInfo) code() string         { return string(p.Code) }
+func (p PackagingInfo) name() string         { return p.Name }
+func (p PackagingInfo) returnEligible() bool { return p.ReturnEligible }
+
+func (p PackagingInfo) clone() PackagingInfo {
+	p.OriginCountries = slices.Clone(p.OriginCountries)
+	return p
+}
+
+// Packagings returns all known package types.
+func Packagings() []PackagingInfo {
+	return packagings.all()
+}
+
+// Info returns the description of the package type. ok is false for unknown
+// package types.
+func (c PackagingCode) Info() (info PackagingInfo, ok bool) {
+	return packagings.info(string(c))
+}
+
+// IsValid reports whether the package type is known.
+func (c PackagingCode) IsValid() bool {
+	return packagings.isValid(string(c))
+}
+
+// Name returns the display name of the package type or the code if it is
+// unknown.
+func (c PackagingCode) Name() string {
+	return packagings.name(string(c))
+}
+
+// IsReturnEligible reports whether the package type is available for return
+// shipments.
+func (c PackagingCode) IsReturnEligible() bool {
+	return isReturnEligible(packagings, string(c))
+}
+
+// IsAvailable reports whether the package type is available from origin to
+// destination.
+func (c PackagingCode) IsAvailable(origin, destination string) bool {
+	info, ok := c.Info()
+	return ok && isAvailable(info.Domestic, info.International, info.OriginCountries, origin, destination)
+}
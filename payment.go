@@ -0,0 +1,208 @@
+package ups
+
+import (
+	"encoding/json"
+	"fmt"
+)
+
+// Shipment charge types of ShipmentCharge.Type.
+const (
+	ShipmentChargeTypeTransportation = "01"
+	ShipmentChargeTypeDutiesAndTaxes = "02"
+	ShipmentChargeTypeBrokerOfChoice = "03"
+)
+
+// Charges returns pointers to ShipmentCharge and AdditionalShipmentCharges.
+func (p *PaymentInformation) Charges() []*ShipmentCharge {
+	charges := []*ShipmentCharge{&p.ShipmentCharge}
+	for i := range p.AdditionalShipmentCharges {
+		charges = append(charges, &p.AdditionalShipmentCharges[i])
+	}
+
+	return charges
+}
+
+// Charge returns the shipment charge of the given type or nil if there is
+// none.
+func (p *PaymentInformation) Charge(chargeType string) *ShipmentCharge {
+	for _, charge := range p.Charges() {
+		if charge.Type == chargeType {
+			return charge
+		}
+	}
+
+	return nil
+}
+
+// MarshalJSON writes ShipmentCharge as a single object and together with
+// AdditionalShipmentCharges as an array if there are further charges.
+func (p PaymentInformation) MarshalJSON() ([]byte, error) {
+	type paymentInformation PaymentInformation
+
+	v := struct {
+		paymentInformation
+		ShipmentCharge any
+	}{
+		paymentInformation: paymentInformation(p),
+		ShipmentCharge:     p.ShipmentCharge,
+	}
+
+	if len(p.AdditionalShipmentCharges) > 0 {
+		v.ShipmentCharge = append([]ShipmentCharge{p.ShipmentCharge}, p.AdditionalShipmentCharges...)
+	}
+
+	return json.Marshal(v)
+}
+
+// UnmarshalJSON reads the first shipment charge into ShipmentCharge and all
+// further charges into AdditionalShipmentCharges.
+func (p *PaymentInformation) UnmarshalJSON(data []byte) error {
+	type paymentInformation PaymentInformation
+
+	v := struct {
+		*paymentInformation
+		ShipmentCharge json.RawMessage
+	}{
+		paymentInformation: (*paymentInformation)(p),
+	}
+
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	var charges []ShipmentCharge
+	if len(v.ShipmentCharge) > 0 {
+		if err := unmarshalOneOrMany(v.ShipmentCharge, &charges); err != nil {
+			return err
+		}
+	}
+
+	p.ShipmentCharge, p.AdditionalShipmentCharges = ShipmentCharge{}, nil
+	if len(charges) > 0 {
+		p.ShipmentCharge = charges[0]
+	}
+
+	if len(charges) > 1 {
+		p.AdditionalShipmentCharges = charges[1:]
+	}
+
+	return nil
+}
+
+// payers returns the number of payers which are set.
+func (c *ShipmentCharge) payers() int {
+	n := 0
+
+	if c.BillShipper != nil {
+		n++
+	}
+
+	if c.BillReceiver != nil {
+		n++
+	}
+
+	if c.BillThirdParty != nil {
+		n++
+	}
+
+	if c.ConsigneeBilledIndicator != "" {
+		n++
+	}
+
+	return n
+}
+
+// isQualifiedDomestic reports whether the shipment is a Qualified Domestic
+// Shipment, for which no duties and taxes are charged.
+func (s *Shipment) isQualifiedDomestic() bool {
+	origin, destination := s.originCountryCode(), s.destinationCountryCode()
+
+	switch {
+	case origin == destination:
+		return true
+	case origin == "US" && destination == "PR", origin == "PR" && destination == "US":
+		return true
+	case isEUCountry(origin) && isEUCountry(destination):
+		return s.GoodsNotInFreeCirculationIndicator == ""
+	}
+
+	return false
+}
+
+func (s *Shipment) validatePayment() []error {
+	if s.PaymentInformation == nil {
+		return nil
+	}
+
+	var errs []error
+	payment := s.PaymentInformation
+	seen := make(map[string]bool)
+
+	for i, charge := range payment.Charges() {
+		field := fmt.Sprintf("Shipment.PaymentInformation.ShipmentCharge[%d]", i)
+
+		switch charge.Type {
+		case ShipmentChargeTypeTransportation, ShipmentChargeTypeDutiesAndTaxes, ShipmentChargeTypeBrokerOfChoice:
+		default:
+			errs = append(errs, newValidationError(field+".Type", "%q is not a valid charge type", charge.Type))
+		}
+
+		if seen[charge.Type] {
+			errs = append(errs, newValidationError(field+".Type", "charge type %s is specified more than once", charge.Type))
+		}
+		seen[charge.Type] = true
+
+		if n := charge.payers(); n != 1 {
+			errs = append(errs, newValidationError(field, "exactly one payer is required, got %d", n))
+		}
+
+		if charge.Type == ShipmentChargeTypeDutiesAndTaxes && s.isQualifiedDomestic() {
+			errs = append(errs, newValidationError(field+".Type", "duties and taxes are invalid for qualified domestic shipments"))
+		}
+
+		if charge.ConsigneeBilledIndicator != "" {
+			origin, destination := s.originCountryCode(), s.destinationCountryCode()
+			if charge.Type != ShipmentChargeTypeTransportation || (origin != "US" && origin != "PR") || (destination != "US" && destination != "PR") {
+				errs = append(errs, newValidationError(field+".ConsigneeBilledIndicator", "only valid for transportation charges of US/PR to US/PR shipments"))
+			}
+		}
+	}
+
+	if !seen[ShipmentChargeTypeTransportation] {
+		errs = append(errs, newValidationError("Shipment.PaymentInformation.ShipmentCharge", "a transportation charge is required"))
+	}
+
+	if payment.SplitDutyVATIndicator != "" {
+		transportation := payment.Charge(ShipmentChargeTypeTransportation)
+		duties := payment.Charge(ShipmentChargeTypeDutiesAndTaxes)
+
+		if transportation == nil || duties == nil {
+			errs = append(errs, newValidationError("Shipment.PaymentInformation.SplitDutyVATIndicator", "requires transportation and duties and taxes charges"))
+		} else if samePayer(transportation, duties) {
+			errs = append(errs, newValidationError("Shipment.PaymentInformation.SplitDutyVATIndicator", "transportation and duties and taxes must be paid by different payers"))
+		}
+	}
+
+	return errs
+}
+
+func samePayer(a, b *ShipmentCharge) bool {
+	return a.payer() == b.payer()
+}
+
+// payer identifies the payer of the charge by its billing option and account
+// number.
+func (c *ShipmentCharge) payer() string {
+	switch {
+	case c.BillShipper != nil:
+		return "shipper " + c.BillShipper.AccountNumber
+	case c.BillReceiver != nil:
+		return "receiver " + c.BillReceiver.AccountNumber
+	case c.BillThirdParty != nil:
+		return "third party " + c.BillThirdParty.AccountNumber
+	case c.ConsigneeBilledIndicator != "":
+		return "consignee"
+	}
+
+	return ""
+}
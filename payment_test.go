@@ -0,0 +1,165 @@
+package ups
+
+import (
+	"encoding/json"
+	"errors"
+	"reflect"
+	"testing"
+)
+
+func TestValidatePayment(t *testing.T) {
+	shipper := &BillShipper{AccountNumber: "A12345"}
+	receiver := &BillReceiver{AccountNumber: "B12345"}
+
+	tests := []struct {
+		name        string
+		origin      string
+		destination string
+		payment     PaymentInformation
+		want        []string
+	}{
+		{
+			name:        "transportation",
+			origin:      "US",
+			destination: "US",
+			payment:     PaymentInformation{ShipmentCharge: ShipmentCharge{Type: ShipmentChargeTypeTransportation, BillShipper: shipper}},
+		},
+		{
+			name:        "three charges with broker of choice",
+			origin:      "US",
+			destination: "CA",
+			payment: PaymentInformation{
+				ShipmentCharge: ShipmentCharge{Type: ShipmentChargeTypeTransportation, BillShipper: shipper},
+				AdditionalShipmentCharges: []ShipmentCharge{
+					{Type: ShipmentChargeTypeDutiesAndTaxes, BillReceiver: receiver},
+					{Type: ShipmentChargeTypeBrokerOfChoice, BillShipper: shipper},
+				},
+			},
+		},
+		{
+			name:        "missing transportation",
+			origin:      "US",
+			destination: "CA",
+			payment:     PaymentInformation{ShipmentCharge: ShipmentCharge{Type: ShipmentChargeTypeDutiesAndTaxes, BillReceiver: receiver}},
+			want:        []string{"Shipment.PaymentInformation.ShipmentCharge"},
+		},
+		{
+			name:        "invalid type and two payers",
+			origin:      "US",
+			destination: "US",
+			payment: PaymentInformation{
+				ShipmentCharge:            ShipmentCharge{Type: ShipmentChargeTypeTransportation, BillShipper: shipper},
+				AdditionalShipmentCharges: []ShipmentCharge{{Type: "04", BillShipper: shipper, BillReceiver: receiver}},
+			},
+			want: []string{
+				"Shipment.PaymentInformation.ShipmentCharge[1].Type",
+				"Shipment.PaymentInformation.ShipmentCharge[1]",
+			},
+		},
+		{
+			name:        "duplicate type",
+			origin:      "US",
+			destination: "US",
+			payment: PaymentInformation{
+				ShipmentCharge:            ShipmentCharge{Type: ShipmentChargeTypeTransportation, BillShipper: shipper},
+				AdditionalShipmentCharges: []ShipmentCharge{{Type: ShipmentChargeTypeTransportation, BillReceiver: receiver}},
+			},
+			want: []string{"Shipment.PaymentInformation.ShipmentCharge[1].Type"},
+		},
+		{
+			name:        "duties and taxes for qualified domestic shipment",
+			origin:      "DE",
+			destination: "FR",
+			payment: PaymentInformation{
+				ShipmentCharge:            ShipmentCharge{Type: ShipmentChargeTypeTransportation, BillShipper: shipper},
+				AdditionalShipmentCharges: []ShipmentCharge{{Type: ShipmentChargeTypeDutiesAndTaxes, BillReceiver: receiver}},
+			},
+			want: []string{"Shipment.PaymentInformation.ShipmentCharge[1].Type"},
+		},
+		{
+			name:        "consignee billed outside US",
+			origin:      "DE",
+			destination: "DE",
+			payment:     PaymentInformation{ShipmentCharge: ShipmentCharge{Type: ShipmentChargeTypeTransportation, ConsigneeBilledIndicator: " "}},
+			want:        []string{"Shipment.PaymentInformation.ShipmentCharge[0].ConsigneeBilledIndicator"},
+		},
+		{
+			name:        "split duty VAT with same payer",
+			origin:      "DE",
+			destination: "CH",
+			payment: PaymentInformation{
+				ShipmentCharge:            ShipmentCharge{Type: ShipmentChargeTypeTransportation, BillShipper: shipper},
+				AdditionalShipmentCharges: []ShipmentCharge{{Type: ShipmentChargeTypeDutiesAndTaxes, BillShipper: shipper}},
+				SplitDutyVATIndicator:     " ",
+			},
+			want: []string{"Shipment.PaymentInformation.SplitDutyVATIndicator"},
+		},
+		{
+			name:        "split duty VAT without duties and taxes",
+			origin:      "DE",
+			destination: "CH",
+			payment: PaymentInformation{
+				ShipmentCharge:        ShipmentCharge{Type: ShipmentChargeTypeTransportation, BillShipper: shipper},
+				SplitDutyVATIndicator: " ",
+			},
+			want: []string{"Shipment.PaymentInformation.SplitDutyVATIndicator"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := testShipment(tt.origin, tt.destination)
+			s.PaymentInformation = &tt.payment
+
+			checkValidationFields(t, errors.Join(s.validatePayment()...), tt.want)
+		})
+	}
+}
+
+func TestPaymentInformationJSON(t *testing.T) {
+	transportation := ShipmentCharge{Type: ShipmentChargeTypeTransportation, BillShipper: &BillShipper{AccountNumber: "A12345"}}
+	duties := ShipmentCharge{Type: ShipmentChargeTypeDutiesAndTaxes, BillReceiver: &BillReceiver{AccountNumber: "B12345"}}
+
+	tests := []struct {
+		name    string
+		payment PaymentInformation
+		want    string
+	}{
+		{
+			name:    "single charge",
+			payment: PaymentInformation{ShipmentCharge: transportation},
+			want:    `{"ShipmentCharge":{"Type":"01","BillShipper":{"AccountNumber":"A12345"}}}`,
+		},
+		{
+			name: "split charges",
+			payment: PaymentInformation{
+				ShipmentCharge:            transportation,
+				AdditionalShipmentCharges: []ShipmentCharge{duties},
+				SplitDutyVATIndicator:     " ",
+			},
+			want: `{"SplitDutyVATIndicator":" ","ShipmentCharge":[{"Type":"01","BillShipper":{"AccountNumber":"A12345"}},{"Type":"02","BillReceiver":{"AccountNumber":"B12345"}}]}`,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data, err := json.Marshal(tt.payment)
+			if err != nil {
+				t.Fatalf("Marshal() error = %v", err)
+			}
+
+			if string(data) != tt.want {
+				t.Errorf("Marshal() = %s, want %s", data, tt.want)
+			}
+
+			var got PaymentInformation
+			if err := json.Unmarshal(data, &got); err != nil {
+				t.Fatalf("Unmarshal() error = %v", err)
+			}
+
+			if !reflect.DeepEqual(got, tt.payment) {
+				t.Errorf("Unmarshal() = %+v, want %+v", got, tt.payment)
+			}
+		})
+	}
+}
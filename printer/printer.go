@@ -0,0 +1,295 @@
+// Package printer sends ZPL, EPL and SPL labels to network thermal printers
+// using raw TCP printing, usually on port 9100.
+package printer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net"
+	"sync"
+	"time"
+
+	"github.com/enthus-golang/ups"
+)
+
+// DefaultPort is used if the printer address does not contain a port.
+const DefaultPort = "9100"
+
+var (
+	// ErrUnsupportedFormat is returned for labels which are not ZPL, EPL or
+	// SPL. Raster labels can't be printed raw.
+	ErrUnsupportedFormat = errors.New("unsupported label format")
+	// ErrClosed is returned if a label is printed after Close was called.
+	ErrClosed = errors.New("printer is closed")
+)
+
+// Printer sends labels to a single printer. Print jobs are queued and sent one
+// after another, so labels of concurrent jobs are never interleaved.
+type Printer struct {
+	address    string
+	timeout    time.Duration
+	retries    int
+	retryDelay time.Duration
+	batchSize  int
+	queueSize  int
+	dial       func(ctx context.Context, network, address string) (net.Conn, error)
+
+	queue  chan *job
+	mu     sync.RWMutex
+	closed bool
+	done   chan struct{}
+}
+
+type job struct {
+	ctx       context.Context
+	documents [][]byte
+	result    chan error
+}
+
+type OptionFunction func(*Printer)
+
+// New creates a Printer for the given address and starts its queue. Close
+// has to be called to stop the queue.
+func New(address string, options ...OptionFunction) *Printer {
+	if _, _, err := net.SplitHostPort(address); err != nil {
+		address = net.JoinHostPort(address, DefaultPort)
+	}
+
+	p := &Printer{
+		address:    address,
+		timeout:    10 * time.Second,
+		retryDelay: time.Second,
+		batchSize:  10,
+		queueSize:  100,
+		done:       make(chan struct{}),
+	}
+
+	for _, option := range options {
+		option(p)
+	}
+
+	p.queue = make(chan *job, p.queueSize)
+	p.dial = (&net.Dialer{Timeout: p.timeout}).DialContext
+
+	go p.run()
+
+	return p
+}
+
+// WithTimeout defines the timeout for connecting to the printer and for
+// sending a batch. Default is 10 seconds.
+func WithTimeout(timeout time.Duration) OptionFunction {
+	return func(p *Printer) {
+		p.timeout = timeout
+	}
+}
+
+// WithRetries defines how often sending a batch is retried and the delay
+// between the attempts. A batch is always resent completely, so a connection
+// which breaks while sending can result in duplicate labels.
+func WithRetries(retries int, delay time.Duration) OptionFunction {
+	return func(p *Printer) {
+		p.retries = retries
+		p.retryDelay = delay
+	}
+}
+
+// WithBatchSize defines how many labels are sent using a single connection.
+// Default is 10.
+func WithBatchSize(size int) OptionFunction {
+	return func(p *Printer) {
+		if size > 0 {
+			p.batchSize = size
+		}
+	}
+}
+
+// WithQueueSize defines how many print jobs can be queued before Print
+// blocks. Default is 100.
+func WithQueueSize(size int) OptionFunction {
+	return func(p *Printer) {
+		if size >= 0 {
+			p.queueSize = size
+		}
+	}
+}
+
+// Address returns the address of the printer including the port.
+func (p *Printer) Address() string {
+	return p.address
+}
+
+// Print queues the labels as a single job and waits until they were sent to
+// the printer. Only ZPL, EPL and SPL labels are accepted.
+func (p *Printer) Print(ctx context.Context, labels ...*ups.ShippingLabel) error {
+	documents := make([][]byte, 0, len(labels))
+
+	for i, label := range labels {
+		if label == nil {
+			return fmt.Errorf("label %d: %w", i+1, ups.ErrNoGraphicImage)
+		}
+
+		switch format := label.Format(); format {
+		case ups.LabelFormatZPL, ups.LabelFormatEPL, ups.LabelFormatSPL:
+		default:
+			return fmt.Errorf("%w: %s", ErrUnsupportedFormat, format)
+		}
+
+		data, err := label.Decode()
+		if err != nil {
+			return err
+		}
+
+		documents = append(documents, data)
+	}
+
+	return p.Send(ctx, documents...)
+}
+
+// PrintPackageResults prints the labels of all packages.
+func (p *Printer) PrintPackageResults(ctx context.Context, results []ups.PackageResults) error {
+	labels := make([]*ups.ShippingLabel, 0, len(results))
+
+	for _, result := range results {
+		if result.ShippingLabel == nil {
+			return fmt.Errorf("package %s: %w", result.TrackingNumber, ups.ErrNoGraphicImage)
+		}
+
+		labels = append(labels, result.ShippingLabel)
+	}
+
+	return p.Print(ctx, labels...)
+}
+
+// Send queues raw printer documents as a single job and waits until they
+// were sent to the printer.
+func (p *Printer) Send(ctx context.Context, documents ...[]byte) error {
+	if len(documents) == 0 {
+		return nil
+	}
+
+	j := &job{
+		ctx:       ctx,
+		documents: documents,
+		result:    make(chan error, 1),
+	}
+
+	err := p.enqueue(j)
+	if err != nil {
+		return err
+	}
+
+	select {
+	case err := <-j.result:
+		return err
+	case <-ctx.Done():
+		return ctx.Err()
+	}
+}
+
+// Close stops accepting new jobs and waits until the queued jobs are sent.
+func (p *Printer) Close() error {
+	p.mu.Lock()
+	if !p.closed {
+		p.closed = true
+		close(p.queue)
+	}
+	p.mu.Unlock()
+
+	<-p.done
+
+	return nil
+}
+
+func (p *Printer) enqueue(j *job) error {
+	p.mu.RLock()
+	defer p.mu.RUnlock()
+
+	if p.closed {
+		return ErrClosed
+	}
+
+	select {
+	case p.queue <- j:
+		return nil
+	case <-j.ctx.Done():
+		return j.ctx.Err()
+	}
+}
+
+func (p *Printer) run() {
+	defer close(p.done)
+
+	for j := range p.queue {
+		j.result <- p.process(j)
+	}
+}
+
+func (p *Printer) process(j *job) error {
+	for start := 0; start < len(j.documents); start += p.batchSize {
+		end := min(start+p.batchSize, len(j.documents))
+
+		err := p.sendBatch(j.ctx, j.documents[start:end])
+		if err != nil {
+			return fmt.Errorf("printer %s: labels %d-%d: %w", p.address, start+1, end, err)
+		}
+	}
+
+	return nil
+}
+
+func (p *Printer) sendBatch(ctx context.Context, batch [][]byte) error {
+	var err error
+
+	for attempt := 0; attempt <= p.retries; attempt++ {
+		if attempt > 0 {
+			select {
+			case <-time.After(p.retryDelay):
+			case <-ctx.Done():
+				return ctx.Err()
+			}
+		}
+
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+
+		err = p.write(ctx, batch)
+		if err == nil {
+			return nil
+		}
+	}
+
+	return err
+}
+
+func (p *Printer) write(ctx context.Context, batch [][]byte) error {
+	conn, err := p.dial(ctx, "tcp", p.address)
+	if err != nil {
+		return err
+	}
+	defer conn.Close()
+
+	var deadline time.Time
+	if p.timeout > 0 {
+		deadline = time.Now().Add(p.timeout)
+	}
+	if d, ok := ctx.Deadline(); ok && (deadline.IsZero() || d.Before(deadline)) {
+		deadline = d
+	}
+
+	err = conn.SetWriteDeadline(deadline)
+	if err != nil {
+		return err
+	}
+
+	for _, document := range batch {
+		_, err = conn.Write(document)
+		if err != nil {
+			return err
+		}
+	}
+
+	return conn.Close()
+}
@@ -0,0 +1,413 @@
+package printer
+
+import (
+	"context"
+	"encoding/base64"
+	"errors"
+	"io"
+	"net"
+	"testing"
+	"time"
+
+	"github.com/enthus-golang/ups"
+)
+
+// testPrinter stands in for a network printer. It records the bytes received
+// per connection.
+type testPrinter struct {
+	listener    net.Listener
+	connections chan []byte
+}
+
+func listen(t *testing.T, address string) *testPrinter {
+	t.Helper()
+
+	l, err := net.Listen("tcp", address)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := &testPrinter{
+		listener:    l,
+		connections: make(chan []byte, 100),
+	}
+
+	go func() {
+		for {
+			conn, err := l.Accept()
+			if err != nil {
+				return
+			}
+
+			data, _ := io.ReadAll(conn)
+			conn.Close()
+
+			p.connections <- data
+		}
+	}()
+
+	t.Cleanup(func() {
+		l.Close()
+	})
+
+	return p
+}
+
+func (p *testPrinter) Addr() string {
+	return p.listener.Addr().String()
+}
+
+// received returns the data of n connections.
+func (p *testPrinter) received(t *testing.T, n int) []string {
+	t.Helper()
+
+	var got []string
+
+	for i := 0; i < n; i++ {
+		select {
+		case data := <-p.connections:
+			got = append(got, string(data))
+		case <-time.After(5 * time.Second):
+			t.Fatalf("received %d connections, want %d", len(got), n)
+		}
+	}
+
+	select {
+	case data := <-p.connections:
+		t.Fatalf("unexpected connection with %q", data)
+	case <-time.After(50 * time.Millisecond):
+	}
+
+	return got
+}
+
+// unusedAddress returns a local address which refuses connections.
+func unusedAddress(t *testing.T) string {
+	t.Helper()
+
+	l, err := net.Listen("tcp", "127.0.0.1:0")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	address := l.Addr().String()
+	l.Close()
+
+	return address
+}
+
+var errRefused = errors.New("connection refused")
+
+// refuse lets the first n connection attempts of p fail and connects the
+// following ones to address. Every refused attempt is reported on the
+// returned channel and waits until it is received.
+func refuse(p *Printer, n int, address string) <-chan struct{} {
+	attempts := make(chan struct{})
+	dial := p.dial
+
+	var count int
+	p.dial = func(ctx context.Context, network, _ string) (net.Conn, error) {
+		count++
+		if count > n {
+			return dial(ctx, network, address)
+		}
+
+		select {
+		case attempts <- struct{}{}:
+		case <-ctx.Done():
+		}
+
+		return nil, errRefused
+	}
+
+	return attempts
+}
+
+func zplLabel(data string) *ups.ShippingLabel {
+	return &ups.ShippingLabel{
+		ImageFormat:  ups.ImageFormat{Code: "ZPL"},
+		GraphicImage: base64.StdEncoding.EncodeToString([]byte(data)),
+	}
+}
+
+func TestNewDefaultPort(t *testing.T) {
+	p := New("192.0.2.1")
+	defer p.Close()
+
+	if got, want := p.Address(), "192.0.2.1:9100"; got != want {
+		t.Errorf("Address() = %s, want %s", got, want)
+	}
+}
+
+func TestSendBatches(t *testing.T) {
+	tests := []struct {
+		name      string
+		batchSize int
+		documents []string
+		want      []string
+	}{
+		{"single batch", 10, []string{"a", "b", "c"}, []string{"abc"}},
+		{"full batches", 2, []string{"a", "b", "c", "d"}, []string{"ab", "cd"}},
+		{"partial last batch", 2, []string{"a", "b", "c", "d", "e"}, []string{"ab", "cd", "e"}},
+		{"one per connection", 1, []string{"a", "b"}, []string{"a", "b"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			server := listen(t, "127.0.0.1:0")
+
+			p := New(server.Addr(), WithBatchSize(tt.batchSize))
+			defer p.Close()
+
+			documents := make([][]byte, len(tt.documents))
+			for i, d := range tt.documents {
+				documents[i] = []byte(d)
+			}
+
+			err := p.Send(context.Background(), documents...)
+			if err != nil {
+				t.Fatalf("Send() error = %v", err)
+			}
+
+			got := server.received(t, len(tt.want))
+			for i := range tt.want {
+				if got[i] != tt.want[i] {
+					t.Errorf("connection %d received %q, want %q", i, got[i], tt.want[i])
+				}
+			}
+		})
+	}
+}
+
+func TestPrintPackageResults(t *testing.T) {
+	server := listen(t, "127.0.0.1:0")
+
+	p := New(server.Addr())
+	defer p.Close()
+
+	results := []ups.PackageResults{
+		{TrackingNumber: "1", ShippingLabel: zplLabel("^XA^FD1^FS^XZ")},
+		{TrackingNumber: "2", ShippingLabel: zplLabel("^XA^FD2^FS^XZ")},
+	}
+
+	err := p.PrintPackageResults(context.Background(), results)
+	if err != nil {
+		t.Fatalf("PrintPackageResults() error = %v", err)
+	}
+
+	if got, want := server.received(t, 1)[0], "^XA^FD1^FS^XZ^XA^FD2^FS^XZ"; got != want {
+		t.Errorf("received %q, want %q", got, want)
+	}
+}
+
+func TestPrintUnsupportedFormat(t *testing.T) {
+	server := listen(t, "127.0.0.1:0")
+
+	p := New(server.Addr())
+	defer p.Close()
+
+	gif := &ups.ShippingLabel{
+		ImageFormat:  ups.ImageFormat{Code: "GIF"},
+		GraphicImage: base64.StdEncoding.EncodeToString([]byte("GIF89a")),
+	}
+
+	err := p.Print(context.Background(), zplLabel("^XA^XZ"), gif)
+	if !errors.Is(err, ErrUnsupportedFormat) {
+		t.Errorf("Print() error = %v, want %v", err, ErrUnsupportedFormat)
+	}
+
+	err = p.Print(context.Background(), zplLabel("^XA^XZ"), nil)
+	if !errors.Is(err, ups.ErrNoGraphicImage) {
+		t.Errorf("Print() of a nil label error = %v, want %v", err, ups.ErrNoGraphicImage)
+	}
+
+	err = p.PrintPackageResults(context.Background(), []ups.PackageResults{{TrackingNumber: "1"}})
+	if !errors.Is(err, ups.ErrNoGraphicImage) {
+		t.Errorf("PrintPackageResults() error = %v, want %v", err, ups.ErrNoGraphicImage)
+	}
+
+	server.received(t, 0)
+}
+
+func TestSendRetryAfterRefusedConnection(t *testing.T) {
+	server := listen(t, "127.0.0.1:0")
+
+	p := New(server.Addr(), WithRetries(50, time.Millisecond))
+	defer p.Close()
+
+	// The first attempts are refused, the printer comes online afterwards.
+	attempts := refuse(p, 2, server.Addr())
+
+	result := make(chan error, 1)
+	go func() {
+		result <- p.Send(context.Background(), []byte("label"))
+	}()
+
+	<-attempts
+
+	select {
+	case err := <-result:
+		t.Fatalf("Send() returned %v after the first refused connection", err)
+	default:
+	}
+
+	<-attempts
+
+	select {
+	case err := <-result:
+		if err != nil {
+			t.Fatalf("Send() error = %v", err)
+		}
+	case <-time.After(5 * time.Second):
+		t.Fatal("Send() did not return")
+	}
+
+	if got := server.received(t, 1)[0]; got != "label" {
+		t.Errorf("received %q, want %q", got, "label")
+	}
+}
+
+func TestSendRetriesExhausted(t *testing.T) {
+	p := New(unusedAddress(t), WithRetries(2, time.Millisecond))
+	defer p.Close()
+
+	err := p.Send(context.Background(), []byte("label"))
+
+	var opErr *net.OpError
+	if !errors.As(err, &opErr) {
+		t.Errorf("Send() error = %v, want connection error", err)
+	}
+}
+
+func TestSendQueueFull(t *testing.T) {
+	// The worker is blocked retrying the first job, so the queue fills up.
+	p := New(unusedAddress(t), WithQueueSize(1), WithRetries(1, time.Hour))
+	defer p.Close()
+
+	attempts := refuse(p, 2, "")
+
+	blockingCtx, cancelBlocking := context.WithCancel(context.Background())
+	defer cancelBlocking()
+
+	blocking := make(chan error, 1)
+	go func() {
+		blocking <- p.Send(blockingCtx, []byte("first"))
+	}()
+
+	// The worker picked up the first job once it connects.
+	<-attempts
+
+	queuedCtx, cancelQueued := context.WithCancel(context.Background())
+	defer cancelQueued()
+
+	// The second job occupies the queue.
+	queued := &job{ctx: queuedCtx, documents: [][]byte{[]byte("second")}, result: make(chan error, 1)}
+	if err := p.enqueue(queued); err != nil {
+		t.Fatalf("enqueue() error = %v", err)
+	}
+
+	ctx, cancel := context.WithTimeout(context.Background(), 50*time.Millisecond)
+	defer cancel()
+
+	err := p.Send(ctx, []byte("third"))
+	if !errors.Is(err, context.DeadlineExceeded) {
+		t.Errorf("Send() on full queue error = %v, want %v", err, context.DeadlineExceeded)
+	}
+
+	cancelBlocking()
+	cancelQueued()
+
+	for _, result := range []chan error{blocking, queued.result} {
+		if err := <-result; !errors.Is(err, context.Canceled) {
+			t.Errorf("Send() error = %v, want %v", err, context.Canceled)
+		}
+	}
+}
+
+func TestSendContextCancellation(t *testing.T) {
+	t.Run("before sending", func(t *testing.T) {
+		server := listen(t, "127.0.0.1:0")
+
+		p := New(server.Addr())
+		defer p.Close()
+
+		ctx, cancel := context.WithCancel(context.Background())
+		cancel()
+
+		err := p.Send(ctx, []byte("label"))
+		if !errors.Is(err, context.Canceled) {
+			t.Errorf("Send() error = %v, want %v", err, context.Canceled)
+		}
+
+		server.received(t, 0)
+	})
+
+	t.Run("while retrying", func(t *testing.T) {
+		p := New(unusedAddress(t), WithRetries(10, time.Hour))
+		defer p.Close()
+
+		attempts := refuse(p, 1, "")
+
+		ctx, cancel := context.WithCancel(context.Background())
+
+		result := make(chan error, 1)
+		go func() {
+			result <- p.Send(ctx, []byte("label"))
+		}()
+
+		<-attempts
+		cancel()
+
+		select {
+		case err := <-result:
+			if !errors.Is(err, context.Canceled) {
+				t.Errorf("Send() error = %v, want %v", err, context.Canceled)
+			}
+		case <-time.After(5 * time.Second):
+			t.Fatal("Send() did not return after cancellation")
+		}
+	})
+}
+
+func TestSendAfterClose(t *testing.T) {
+	server := listen(t, "127.0.0.1:0")
+
+	p := New(server.Addr())
+	p.Close()
+
+	err := p.Send(context.Background(), []byte("label"))
+	if !errors.Is(err, ErrClosed) {
+		t.Errorf("Send() error = %v, want %v", err, ErrClosed)
+	}
+}
+
+func TestSendConcurrentJobs(t *testing.T) {
+	server := listen(t, "127.0.0.1:0")
+
+	p := New(server.Addr())
+
+	results := make(chan error, 3)
+	for _, label := range []string{"a", "b", "c"} {
+		go func(label string) {
+			results <- p.Send(context.Background(), []byte(label))
+		}(label)
+	}
+
+	for i := 0; i < 3; i++ {
+		if err := <-results; err != nil {
+			t.Errorf("Send() error = %v", err)
+		}
+	}
+
+	p.Close()
+
+	got := server.received(t, 3)
+	seen := map[string]bool{}
+	for _, data := range got {
+		seen[data] = true
+	}
+
+	if !seen["a"] || !seen["b"] || !seen["c"] {
+		t.Errorf("received %q, want a, b and c as separate jobs", got)
+	}
+}
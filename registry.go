@@ -0,0 +1,84 @@
+package ups
+
+import (
+	"slices"
+	"strings"
+)
+
+// codeInfo is implemented by the descriptions of the codes of a registry,
+// e.g. ServiceInfo.
+type codeInfo[I any] interface {
+	code() string
+	name() string
+	// clone returns a deep copy, so callers can't modify the registry.
+	clone() I
+}
+
+// registry contains the descriptions of all known values of a code.
+type registry[I codeInfo[I]] []I
+
+// all returns a copy of all descriptions.
+func (r registry[I]) all() []I {
+	all := make([]I, len(r))
+	for i, info := range r {
+		all[i] = info.clone()
+	}
+
+	return all
+}
+
+// info returns a copy of the description of code. ok is false for unknown
+// codes.
+func (r registry[I]) info(code string) (info I, ok bool) {
+	i := slices.IndexFunc(r, func(info I) bool { return info.code() == code })
+	if i < 0 {
+		return info, false
+	}
+
+	return r[i].clone(), true
+}
+
+// isValid reports whether code is known.
+func (r registry[I]) isValid(code string) bool {
+	_, ok := r.info(code)
+	return ok
+}
+
+// name returns the display name of code or code itself if it is unknown.
+func (r registry[I]) name(code string) string {
+	if info, ok := r.info(code); ok {
+		return info.name()
+	}
+
+	return code
+}
+
+// returnCodeInfo is implemented by the descriptions of codes which are not
+// all available for return shipments.
+type returnCodeInfo[I any] interface {
+	codeInfo[I]
+	returnEligible() bool
+}
+
+// isReturnEligible reports whether code is known and available for return
+// shipments.
+func isReturnEligible[I returnCodeInfo[I]](r registry[I], code string) bool {
+	info, ok := r.info(code)
+	return ok && info.returnEligible()
+}
+
+// isAvailable reports whether a code available from originCountries, or
+// worldwide if it is empty, can be used from origin to destination.
+func isAvailable(domestic, international bool, originCountries []string, origin, destination string) bool {
+	origin, destination = strings.ToUpper(origin), strings.ToUpper(destination)
+
+	if len(originCountries) > 0 && !slices.Contains(originCountries, origin) {
+		return false
+	}
+
+	if isDomestic(origin, destination) {
+		return domestic
+	}
+
+	return international
+}
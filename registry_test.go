@@ -0,0 +1,146 @@
+package ups
+
+import "testing"
+
+func TestServiceCodeIsAvailable(t *testing.T) {
+	tests := []struct {
+		code        ServiceCode
+		origin      string
+		destination string
+		want        bool
+	}{
+		{ServiceGround, "US", "US", true},
+		{ServiceGround, "us", "pr", true},
+		{ServiceGround, "US", "CA", true},
+		{ServiceGround, "DE", "DE", false},
+		{ServiceNextDayAir, "US", "US", true},
+		{ServiceNextDayAir, "CA", "US", true},
+		{ServiceNextDayAir, "DE", "US", false},
+		{ServiceExpress1200, "DE", "DE", true},
+		{ServiceExpress1200, "AT", "DE", true},
+		{ServiceStandard, "DE", "FR", true},
+		{ServiceWorldwideExpress, "DE", "DE", false},
+		{ServiceTodayStandard, "PL", "PL", true},
+		{ServiceTodayStandard, "DE", "DE", false},
+		{"99", "US", "US", false},
+	}
+
+	for _, tt := range tests {
+		if got := tt.code.IsAvailable(tt.origin, tt.destination); got != tt.want {
+			t.Errorf("ServiceCode(%s).IsAvailable(%s, %s) = %t, want %t", tt.code, tt.origin, tt.destination, got, tt.want)
+		}
+	}
+}
+
+func TestServicesFor(t *testing.T) {
+	services := ServicesFor("US", "CA", true)
+
+	for _, s := range services {
+		if !s.ReturnEligible {
+			t.Errorf("ServicesFor() returned %s, which is not return eligible", s.Code)
+		}
+	}
+
+	for _, code := range []ServiceCode{ServiceGround, ServiceStandard, ServiceWorldwideExpress} {
+		found := false
+		for _, s := range services {
+			found = found || s.Code == code
+		}
+
+		if !found {
+			t.Errorf("ServicesFor(US, CA) does not contain %s", code)
+		}
+	}
+}
+
+func TestRegistryCopies(t *testing.T) {
+	services := Services()
+	services[0].OriginCountries[0] = "XX"
+
+	if info, _ := ServiceNextDayAir.Info(); info.OriginCountries[0] != "US" {
+		t.Errorf("modifying Services() changed the registry: %v", info.OriginCountries)
+	}
+
+	info, _ := ServiceGround.Info()
+	info.OriginCountries[0] = "XX"
+
+	if !isDomestic("US", "PR") {
+		t.Error("modifying Info() changed the US origins")
+	}
+
+	packagings := Packagings()
+	for i := range packagings {
+		if len(packagings[i].OriginCountries) > 0 {
+			packagings[i].OriginCountries[0] = "XX"
+		}
+	}
+
+	if !PackagingFlats.IsAvailable("US", "US") {
+		t.Error("modifying Packagings() changed the registry")
+	}
+}
+
+func TestCodeNames(t *testing.T) {
+	tests := []struct {
+		name string
+		got  string
+		want string
+	}{
+		{"service", ServiceGround.Name(), "UPS Ground"},
+		{"unknown service", ServiceCode("99").Name(), "99"},
+		{"packaging", PackagingPAK.Name(), "PAK"},
+		{"notification", NotificationCodeDelivery.Name(), "Delivery Notification"},
+		{"weight unit", WeightUnit("kgs").Name(), "Kilograms"},
+		{"dimension unit", DimensionUnitEnglish.Name(), "English Units Of Measurement"},
+		{"unknown unit", DimensionUnit("MM").Name(), "MM"},
+	}
+
+	for _, tt := range tests {
+		if tt.got != tt.want {
+			t.Errorf("%s Name() = %q, want %q", tt.name, tt.got, tt.want)
+		}
+	}
+}
+
+func TestCodeValidity(t *testing.T) {
+	tests := []struct {
+		name               string
+		valid              bool
+		wantValid          bool
+		returnEligible     bool
+		wantReturnEligible bool
+	}{
+		{"service", ServiceGround.IsValid(), true, ServiceGround.IsReturnEligible(), true},
+		{"forward only service", ServiceNextDayAirSaver.IsValid(), true, ServiceNextDayAirSaver.IsReturnEligible(), false},
+		{"unknown service", ServiceCode("99").IsValid(), false, ServiceCode("99").IsReturnEligible(), false},
+		{"forward only packaging", PackagingUPS10KGBox.IsValid(), true, PackagingUPS10KGBox.IsReturnEligible(), false},
+		{"return notification", NotificationCodeInTransit.IsValid(), true, NotificationCodeInTransit.IsReturnEligible(), true},
+		{"forward notification", NotificationCodeShip.IsValid(), true, NotificationCodeShip.IsReturnEligible(), false},
+	}
+
+	for _, tt := range tests {
+		if tt.valid != tt.wantValid || tt.returnEligible != tt.wantReturnEligible {
+			t.Errorf("%s IsValid() = %t, IsReturnEligible() = %t, want %t, %t", tt.name, tt.valid, tt.returnEligible, tt.wantValid, tt.wantReturnEligible)
+		}
+	}
+
+	if !WeightUnit("lbs").IsValid() || WeightUnit("LB").IsValid() || !DimensionUnit("cm").IsMetric() || WeightUnitOunces.IsMetric() {
+		t.Error("unexpected unit validity")
+	}
+}
+
+func TestCodeConstantsAssignable(t *testing.T) {
+	s := Shipment{
+		Service: Service{Code: string(ServiceGround)},
+		Packages: []Package{{
+			Packaging:     Packaging{Code: string(PackagingCustomerSupplied)},
+			Dimensions:    Dimensions{UnitOfMeasurement: DimensionsUnitOfMeasurement{Code: string(DimensionUnitInches)}},
+			PackageWeight: &PackageWeight{UnitOfMeasurement: PackageWeightUnitOfMeasurement{Code: string(WeightUnitPounds)}},
+		}},
+		ShipmentServiceOptions: &ShipmentServiceOptions{Notifications: []Notification{{NotificationCode: string(NotificationCodeDelivery)}}},
+	}
+
+	if ServiceCode(s.Service.Code) != ServiceGround || PackagingCode(s.Packages[0].Packaging.Code) != PackagingCustomerSupplied {
+		t.Errorf("Shipment = %+v", s)
+	}
+}
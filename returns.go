@@ -0,0 +1,185 @@
+package ups
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+)
+
+// Return services of ReturnService.Code.
+const (
+	ReturnServicePrintAndMail             = "2"
+	ReturnServiceOneAttempt               = "3"
+	ReturnServiceThreeAttempt             = "5"
+	ReturnServiceElectronicReturnLabel    = "8"
+	ReturnServicePrintReturnLabel         = "9"
+	ReturnServiceExchangePrintReturnLabel = "10"
+)
+
+// maxReturnPackages is the maximum number of packages of a return shipment.
+const maxReturnPackages = 20
+
+// IsReturn reports whether the shipment is a return shipment.
+func (s *Shipment) IsReturn() bool {
+	return s.ReturnService != nil
+}
+
+// isValidReturnService reports whether code is a return service, including
+// the Pack & Collect services 11 to 20.
+func isValidReturnService(code string) bool {
+	switch code {
+	case ReturnServicePrintAndMail, ReturnServiceOneAttempt, ReturnServiceThreeAttempt, ReturnServiceElectronicReturnLabel,
+		ReturnServicePrintReturnLabel, ReturnServiceExchangePrintReturnLabel:
+		return true
+	}
+
+	n, err := strconv.Atoi(code)
+
+	return err == nil && n >= 11 && n <= 20
+}
+
+// NewReturnShipmentRequest builds the request for a return of the original
+// shipment. The shipper stays the account holder paying for the shipment, the
+// receiver of the original shipment becomes ShipFrom and the original origin
+// becomes ShipTo. Forward only options like COD and InvoiceLineTotal are
+// removed and the package descriptions, which are required for returns, are
+// defaulted to the shipment description.
+func NewReturnShipmentRequest(original ShipmentRequest, returnService string) (ShipmentRequest, error) {
+	if !isValidReturnService(returnService) {
+		return ShipmentRequest{}, fmt.Errorf("%q is not a valid return service", returnService)
+	}
+
+	request := original
+	shipment := &request.Shipment
+
+	shipment.ReturnService = &ReturnService{Code: returnService}
+	shipment.ShipFrom = &ShipFrom{
+		Name:                    original.Shipment.ShipTo.Name,
+		AttentionName:           original.Shipment.ShipTo.AttentionName,
+		CompanyDisplayableName:  original.Shipment.ShipTo.CompanyDisplayableName,
+		TaxIdentificationNumber: original.Shipment.ShipTo.TaxIdentificationNumber,
+		Phone:                   original.Shipment.ShipTo.Phone,
+		FaxNumber:               original.Shipment.ShipTo.FaxNumber,
+		Address:                 original.Shipment.ShipTo.Address,
+	}
+
+	if from := original.Shipment.ShipFrom; from != nil {
+		shipment.ShipTo = ShipTo{
+			Name:                    from.Name,
+			AttentionName:           from.AttentionName,
+			CompanyDisplayableName:  from.CompanyDisplayableName,
+			TaxIdentificationNumber: from.TaxIdentificationNumber,
+			Phone:                   from.Phone,
+			FaxNumber:               from.FaxNumber,
+			Address:                 from.Address,
+		}
+	} else {
+		shipper := original.Shipment.Shipper
+		shipment.ShipTo = ShipTo{
+			Name:                    shipper.Name,
+			AttentionName:           shipper.AttentionName,
+			CompanyDisplayableName:  shipper.CompanyDisplayableName,
+			TaxIdentificationNumber: shipper.TaxIdentificationNumber,
+			Phone:                   shipper.Phone,
+			FaxNumber:               shipper.FaxNumber,
+			EMailAddress:            shipper.EMailAddress,
+			Address: ShipToAddress{
+				AddressLines:      shipper.Address.AddressLines,
+				City:              shipper.Address.City,
+				StateProvinceCode: shipper.Address.StateProvinceCode,
+				PostalCode:        shipper.Address.PostalCode,
+				CountryCode:       shipper.Address.CountryCode,
+			},
+		}
+	}
+
+	shipment.InvoiceLineTotal = nil
+	shipment.ShipmentIndicationType = nil
+	shipment.AlternateDeliveryAddress = nil
+
+	if options := original.Shipment.ShipmentServiceOptions; options != nil {
+		returnOptions := *options
+		returnOptions.COD = nil
+		returnOptions.AccessPointCOD = nil
+		returnOptions.ImportControlIndicator = ""
+		returnOptions.ExchangeForwardIndicator = ""
+		shipment.ShipmentServiceOptions = &returnOptions
+	}
+
+	shipment.Packages = slices.Clone(original.Shipment.Packages)
+	for i := range shipment.Packages {
+		p := &shipment.Packages[i]
+
+		if p.Description == "" {
+			p.Description = original.Shipment.Description
+		}
+
+		if p.PackageServiceOptions != nil {
+			options := *p.PackageServiceOptions
+			options.COD = nil
+			p.PackageServiceOptions = &options
+		}
+	}
+
+	return request, nil
+}
+
+// CreateReturnShipment creates a return shipment for the original shipment
+// request using NewReturnShipmentRequest.
+func (c *Client) CreateReturnShipment(ctx context.Context, original ShipmentRequest, returnService string) (*ShipmentResponse, error) {
+	request, err := NewReturnShipmentRequest(original, returnService)
+	if err != nil {
+		return nil, err
+	}
+
+	return c.CreateShipment(ctx, request)
+}
+
+func (s *Shipment) validateReturnService() []error {
+	if s.ReturnService == nil {
+		return nil
+	}
+
+	var errs []error
+
+	if !isValidReturnService(s.ReturnService.Code) {
+		errs = append(errs, newValidationError("Shipment.ReturnService.Code", "%q is not a valid return service", s.ReturnService.Code))
+	}
+
+	if s.ShipFrom == nil {
+		errs = append(errs, newValidationError("Shipment.ShipFrom", "is required for return shipments"))
+	}
+
+	if code := ServiceCode(s.Service.Code); code.IsValid() && !code.IsReturnEligible() {
+		errs = append(errs, newValidationError("Shipment.Service.Code", "service %s is not available for return shipments", code))
+	}
+
+	if options := s.ShipmentServiceOptions; options != nil {
+		if options.ImportControlIndicator != "" {
+			errs = append(errs, newValidationError("Shipment.ShipmentServiceOptions.ImportControlIndicator", "not valid for return shipments"))
+		}
+
+		if options.ExchangeForwardIndicator != "" {
+			errs = append(errs, newValidationError("Shipment.ShipmentServiceOptions.ExchangeForwardIndicator", "not valid for return shipments"))
+		}
+	}
+
+	if origin := s.originCountryCode(); (origin == "US" || origin == "PR") && len(s.Packages) > 1 {
+		errs = append(errs, newValidationError("Shipment.Package", "returns from %s are limited to one package", origin))
+	} else if len(s.Packages) > maxReturnPackages {
+		errs = append(errs, newValidationError("Shipment.Package", "up to %d packages are allowed for return shipments", maxReturnPackages))
+	}
+
+	for i, p := range s.Packages {
+		if p.Description == "" {
+			errs = append(errs, newValidationError(fmt.Sprintf("Shipment.Package[%d].Description", i), "is required for return shipments"))
+		}
+
+		if code := PackagingCode(p.Packaging.Code); code.IsValid() && !code.IsReturnEligible() {
+			errs = append(errs, newValidationError(fmt.Sprintf("Shipment.Package[%d].Packaging.Code", i), "package type %s is not available for return shipments", code))
+		}
+	}
+
+	return errs
+}
@@ -0,0 +1,214 @@
+package ups
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestIsValidReturnService(t *testing.T) {
+	tests := []struct {
+		code string
+		want bool
+	}{
+		{ReturnServicePrintAndMail, true},
+		{ReturnServiceExchangePrintReturnLabel, true},
+		{"11", true},
+		{"20", true},
+		{"1", false},
+		{"21", false},
+		{"", false},
+	}
+
+	for _, tt := range tests {
+		if got := isValidReturnService(tt.code); got != tt.want {
+			t.Errorf("isValidReturnService(%q) = %t, want %t", tt.code, got, tt.want)
+		}
+	}
+}
+
+func TestValidateReturnService(t *testing.T) {
+	tests := []struct {
+		name     string
+		origin   string
+		code     string
+		shipFrom bool
+		service  ServiceCode
+		options  *ShipmentServiceOptions
+		packages []Package
+		want     []string
+	}{
+		{
+			name:     "valid",
+			origin:   "DE",
+			code:     ReturnServicePrintReturnLabel,
+			shipFrom: true,
+			service:  ServiceStandard,
+			packages: []Package{{Description: "Shoes", Packaging: Packaging{Code: string(PackagingCustomerSupplied)}}},
+		},
+		{
+			name:     "pack and collect",
+			origin:   "DE",
+			code:     "14",
+			shipFrom: true,
+			packages: []Package{{Description: "Shoes"}},
+		},
+		{
+			name:     "invalid return service without ShipFrom",
+			origin:   "DE",
+			code:     "1",
+			packages: []Package{{Description: "Shoes"}},
+			want:     []string{"Shipment.ReturnService.Code", "Shipment.ShipFrom"},
+		},
+		{
+			name:     "service not eligible",
+			origin:   "US",
+			code:     ReturnServicePrintReturnLabel,
+			shipFrom: true,
+			service:  ServiceNextDayAirSaver,
+			packages: []Package{{Description: "Shoes"}},
+			want:     []string{"Shipment.Service.Code"},
+		},
+		{
+			name:     "forward only options",
+			origin:   "DE",
+			code:     ReturnServicePrintReturnLabel,
+			shipFrom: true,
+			options:  &ShipmentServiceOptions{ImportControlIndicator: " ", ExchangeForwardIndicator: " "},
+			packages: []Package{{Description: "Shoes"}},
+			want: []string{
+				"Shipment.ShipmentServiceOptions.ImportControlIndicator",
+				"Shipment.ShipmentServiceOptions.ExchangeForwardIndicator",
+			},
+		},
+		{
+			name:     "several packages from the US",
+			origin:   "US",
+			code:     ReturnServicePrintReturnLabel,
+			shipFrom: true,
+			packages: []Package{{Description: "Shoes"}, {Description: "Shirts"}},
+			want:     []string{"Shipment.Package"},
+		},
+		{
+			name:     "too many packages",
+			origin:   "DE",
+			code:     ReturnServicePrintReturnLabel,
+			shipFrom: true,
+			packages: func() []Package {
+				packages := make([]Package, maxReturnPackages+1)
+				for i := range packages {
+					packages[i].Description = "Shoes"
+				}
+				return packages
+			}(),
+			want: []string{"Shipment.Package"},
+		},
+		{
+			name:     "package without description and ineligible package type",
+			origin:   "DE",
+			code:     ReturnServicePrintReturnLabel,
+			shipFrom: true,
+			packages: []Package{{Description: "Shoes"}, {Packaging: Packaging{Code: string(PackagingUPS25KGBox)}}},
+			want:     []string{"Shipment.Package[1].Description", "Shipment.Package[1].Packaging.Code"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			s := testShipment(tt.origin, tt.origin)
+			s.ReturnService = &ReturnService{Code: tt.code}
+			s.Service.Code = string(tt.service)
+			s.ShipmentServiceOptions = tt.options
+			s.Packages = tt.packages
+
+			if tt.shipFrom {
+				s.ShipFrom = &ShipFrom{Address: ShipToAddress{CountryCode: tt.origin}}
+			}
+
+			checkValidationFields(t, errors.Join(s.validateReturnService()...), tt.want)
+		})
+	}
+
+	s := testShipment("DE", "DE")
+	if errs := s.validateReturnService(); errs != nil {
+		t.Errorf("validateReturnService() of a forward shipment = %v, want nil", errs)
+	}
+}
+
+func TestNewReturnShipmentRequest(t *testing.T) {
+	cod := &COD{CODFundsCode: "1", CODAmount: CODAmount{CurrencyCode: "EUR", MonetaryValue: "100"}}
+
+	tests := []struct {
+		name          string
+		shipFrom      *ShipFrom
+		returnService string
+		wantShipTo    string
+		wantErr       bool
+	}{
+		{
+			name:          "shipper as return address",
+			returnService: ReturnServicePrintReturnLabel,
+			wantShipTo:    "Shipper",
+		},
+		{
+			name:          "ShipFrom as return address",
+			shipFrom:      &ShipFrom{Name: "Warehouse", Address: ShipToAddress{CountryCode: "DE"}},
+			returnService: ReturnServiceElectronicReturnLabel,
+			wantShipTo:    "Warehouse",
+		},
+		{
+			name:          "invalid return service",
+			returnService: "1",
+			wantErr:       true,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			original := ShipmentRequest{Shipment: testShipment("DE", "FR")}
+			original.Shipment.Description = "Shoes"
+			original.Shipment.Shipper.Name = "Shipper"
+			original.Shipment.ShipTo.Name = "Receiver"
+			original.Shipment.ShipFrom = tt.shipFrom
+			original.Shipment.InvoiceLineTotal = &InvoiceLineTotal{CurrencyCode: "EUR", MonetaryValue: "100"}
+			original.Shipment.ShipmentServiceOptions = &ShipmentServiceOptions{COD: cod, ImportControlIndicator: " "}
+			original.Shipment.Packages = []Package{
+				{PackageServiceOptions: &PackageServiceOptions{COD: cod}},
+				{Description: "Shirts"},
+			}
+
+			request, err := NewReturnShipmentRequest(original, tt.returnService)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("NewReturnShipmentRequest() error = %v, want error %t", err, tt.wantErr)
+			}
+
+			if err != nil {
+				return
+			}
+
+			s := request.Shipment
+			if s.ReturnService == nil || s.ReturnService.Code != tt.returnService {
+				t.Errorf("ReturnService = %+v, want %s", s.ReturnService, tt.returnService)
+			}
+
+			if s.ShipFrom == nil || s.ShipFrom.Name != "Receiver" || s.ShipFrom.Address.CountryCode != "FR" {
+				t.Errorf("ShipFrom = %+v, want the original receiver", s.ShipFrom)
+			}
+
+			if s.ShipTo.Name != tt.wantShipTo || s.ShipTo.Address.CountryCode != "DE" {
+				t.Errorf("ShipTo = %+v, want %s", s.ShipTo, tt.wantShipTo)
+			}
+
+			if s.InvoiceLineTotal != nil || s.ShipmentServiceOptions.COD != nil || s.ShipmentServiceOptions.ImportControlIndicator != "" {
+				t.Errorf("forward only options were not removed: %+v", s.ShipmentServiceOptions)
+			}
+
+			if s.Packages[0].Description != "Shoes" || s.Packages[1].Description != "Shirts" || s.Packages[0].PackageServiceOptions.COD != nil {
+				t.Errorf("Packages = %+v, want descriptions and no COD", s.Packages)
+			}
+
+			if original.Shipment.ShipmentServiceOptions.COD == nil || original.Shipment.Packages[0].PackageServiceOptions.COD == nil {
+				t.Error("NewReturnShipmentRequest() modified the original request")
+			}
+		})
+	}
+}
@@ -0,0 +1,162 @@
+package ups
+
+import "slices"
+
+// ServiceCode is the UPS service of Service.Code.
+type ServiceCode string
+
+// Services of Service.Code. Service.Code is a string, so the constants are
+// assigned with string(ServiceGround).
+const (
+	ServiceNextDayAir                    ServiceCode = "01"
+	Service2ndDayAir                     ServiceCode = "02"
+	ServiceGround                        ServiceCode = "03"
+	ServiceWorldwideExpress              ServiceCode = "07"
+	ServiceWorldwideExpedited            ServiceCode = "08"
+	ServiceStandard                      ServiceCode = "11"
+	Service3DaySelect                    ServiceCode = "12"
+	ServiceNextDayAirSaver               ServiceCode = "13"
+	ServiceNextDayAirEarly               ServiceCode = "14"
+	ServiceWorldwideEconomyDDU           ServiceCode = "17"
+	ServiceWorldwideExpressPlus          ServiceCode = "54"
+	Service2ndDayAirAM                   ServiceCode = "59"
+	ServiceSaver                         ServiceCode = "65"
+	ServiceAccessPointEconomy            ServiceCode = "70"
+	ServiceWorldwideExpressFreightMidday ServiceCode = "71"
+	ServiceWorldwideEconomy              ServiceCode = "72"
+	ServiceExpress1200                   ServiceCode = "74"
+	ServiceTodayStandard                 ServiceCode = "82"
+	ServiceTodayDedicatedCourier         ServiceCode = "83"
+	ServiceTodayIntercity                ServiceCode = "84"
+	ServiceTodayExpress                  ServiceCode = "85"
+	ServiceTodayExpressSaver             ServiceCode = "86"
+	ServiceWorldwideExpressFreight       ServiceCode = "96"
+	ServiceFirstClassMail                ServiceCode = "M2"
+	ServicePriorityMail                  ServiceCode = "M3"
+	ServiceExpeditedMailInnovations      ServiceCode = "M4"
+	ServicePriorityMailInnovations       ServiceCode = "M5"
+	ServiceEconomyMailInnovations        ServiceCode = "M6"
+	ServiceMailInnovationsReturns        ServiceCode = "M7"
+)
+
+// ServiceInfo describes a UPS service.
+type ServiceInfo struct {
+	Code ServiceCode
+	// Name to display, e.g. in a service picker.
+	Name string
+	// Domestic is true if the service is available within a country.
+	Domestic bool
+	// International is true if the service is available across borders.
+	International bool
+	// ReturnEligible is true if the service is available for return
+	// shipments.
+	ReturnEligible bool
+	// OriginCountries contains the countries or territories the service is
+	// available from. It is empty if the service is available worldwide.
+	OriginCountries []string
+}
+
+var (
+	usOrigins = []string{"US", "PR"}
+	// northAmericaOrigins contains the origins of the US air services, which
+	// UPS also offers from Canada as UPS Express, Expedited and Express
+	// Saver.
+	northAmericaOrigins = []string{"US", "PR", "CA"}
+	todayOrigins        = []string{"PL"}
+)
+
+// services contains all services of Service.Code.
+var services = registry[ServiceInfo]{
+	{ServiceNextDayAir, "UPS Next Day Air", true, true, true, northAmericaOrigins},
+	{Service2ndDayAir, "UPS 2nd Day Air", true, true, true, northAmericaOrigins},
+	{ServiceGround, "UPS Ground", true, true, true, usOrigins},
+	{ServiceWorldwideExpress, "UPS Worldwide Express", false, true, true, nil},
+	{ServiceWorldwideExpedited, "UPS Worldwide Expedited", false, true, true, nil},
+	{ServiceStandard, "UPS Standard", true, true, true, nil},
+	{Service3DaySelect, "UPS 3 Day Select", true, true, true, northAmericaOrigins},
+	{ServiceNextDayAirSaver, "UPS Next Day Air Saver", true, true, false, northAmericaOrigins},
+	{ServiceNextDayAirEarly, "UPS Next Day Air Early", true, true, true, northAmericaOrigins},
+	{ServiceWorldwideEconomyDDU, "UPS Worldwide Economy DDU", false, true, true, nil},
+	{ServiceWorldwideExpressPlus, "UPS Worldwide Express Plus", true, true, true, nil},
+	{Service2ndDayAirAM, "UPS 2nd Day Air A.M.", true, false, false, usOrigins},
+	{ServiceSaver, "UPS Saver", true, true, true, nil},
+	{ServiceAccessPointEconomy, "UPS Access Point Economy", true, true, true, euCountries},
+	{ServiceWorldwideExpressFreightMidday, "UPS Worldwide Express Freight Midday", false, true, true, nil},
+	{ServiceWorldwideEconomy, "UPS Worldwide Economy", false, true, true, nil},
+	{ServiceExpress1200, "UPS Express 12:00", true, true, true, nil},
+	{ServiceTodayStandard, "UPS Today Standard", true, false, false, todayOrigins},
+	{ServiceTodayDedicatedCourier, "UPS Today Dedicated Courier", true, false, false, todayOrigins},
+	{ServiceTodayIntercity, "UPS Today Intercity", true, false, false, todayOrigins},
+	{ServiceTodayExpress, "UPS Today Express", true, false, false, todayOrigins},
+	{ServiceTodayExpressSaver, "UPS Today Express Saver", true, false, false, todayOrigins},
+	{ServiceWorldwideExpressFreight, "UPS Worldwide Express Freight", false, true, true, nil},
+	{ServiceFirstClassMail, "First Class Mail", true, false, false, usOrigins},
+	{ServicePriorityMail, "Priority Mail", true, false, false, usOrigins},
+	{ServiceExpeditedMailInnovations, "Expedited Mail Innovations", true, true, false, usOrigins},
+	{ServicePriorityMailInnovations, "Priority Mail Innovations", false, true, false, usOrigins},
+	{ServiceEconomyMailInnovations, "Economy Mail Innovations", false, true, false, usOrigins},
+	{ServiceMailInnovationsReturns, "Mail Innovations Returns", true, false, true, usOrigins},
+}
+
+func (s ServiceInfo) code() string         { return string(s.Code) }
+func (s ServiceInfo) name() string         { return s.Name }
+func (s ServiceInfo) returnEligible() bool { return s.ReturnEligible }
+
+func (s ServiceInfo) clone() ServiceInfo {
+	s.OriginCountries = slices.Clone(s.OriginCountries)
+	return s
+}
+
+// Services returns all known services.
+func Services() []ServiceInfo {
+	return services.all()
+}
+
+// Info returns the description of the service. ok is false for unknown
+// services.
+func (c ServiceCode) Info() (info ServiceInfo, ok bool) {
+	return services.info(string(c))
+}
+
+// IsValid reports whether the service is known.
+func (c ServiceCode) IsValid() bool {
+	return services.isValid(string(c))
+}
+
+// Name returns the display name of the service or the code if it is unknown.
+func (c ServiceCode) Name() string {
+	return services.name(string(c))
+}
+
+// IsReturnEligible reports whether the service is available for return
+// shipments.
+func (c ServiceCode) IsReturnEligible() bool {
+	return isReturnEligible(services, string(c))
+}
+
+// IsAvailable reports whether the service is available from origin to
+// destination.
+func (c ServiceCode) IsAvailable(origin, destination string) bool {
+	info, ok := c.Info()
+	return ok && isAvailable(info.Domestic, info.International, info.OriginCountries, origin, destination)
+}
+
+// isDomestic reports whether a shipment from origin to destination is
+// domestic. US and PR count as one domestic area.
+func isDomestic(origin, destination string) bool {
+	return origin == destination || (slices.Contains(usOrigins, origin) && slices.Contains(usOrigins, destination))
+}
+
+// ServicesFor returns the services available from origin to destination,
+// optionally limited to the services eligible for return shipments.
+func ServicesFor(origin, destination string, returns bool) []ServiceInfo {
+	var available []ServiceInfo
+
+	for _, s := range services {
+		if s.Code.IsAvailable(origin, destination) && (!returns || s.ReturnEligible) {
+			available = append(available, s.clone())
+		}
+	}
+
+	return available
+}
@@ -10,7 +10,10 @@ type ShipmentRequest struct {
 	// Control Label shipments with SubVersion greater than or equal to 1707.
 	LabelSpecification *LabelSpecification
 
-	// TODO: implement ReceiptSpecification
+	// Container used to define the properties required by the user to print
+	// and/or display the UPS shipping receipt. Receipts are returned for
+	// drop-off shipments in PackageResults.ShippingReceipt.
+	ReceiptSpecification *ReceiptSpecification `json:",omitempty"`
 }
 
 type Shipment struct {
@@ -18,7 +21,10 @@ type Shipment struct {
 	Shipper     Shipper
 	ShipTo      ShipTo
 
-	// TODO: implement "AlternateDeliveryAddress"
+	// Alternate Delivery Address container. Required for shipments with
+	// ShipmentIndicationType 01 = Hold for Pickup at UPS Access Point or 02 =
+	// UPS Access Point Delivery.
+	AlternateDeliveryAddress *AlternateDeliveryAddress `json:",omitempty"`
 
 	ShipFrom *ShipFrom `json:",omitempty"`
 	// Payment information container for detailed shipment charges. The two
@@ -29,20 +35,31 @@ type Shipment struct {
 	// Required for Ground Freight Pricing Shipments only.
 	FRSPaymentInformation *FRSPaymentInformation `json:",omitempty"`
 
-	// TODO: implement FreightShipmentInformation
+	// Container to hold density information of Ground Freight Pricing
+	// shipments.
+	FreightShipmentInformation *FreightShipmentInformation `json:",omitempty"`
 
 	GoodsNotInFreeCirculationIndicator string `json:",omitempty"`
 
 	// TODO: implement PromotionalDiscountInformation
-	// TODO: implement DGSignatoryInfo
+	// DGSignatoryInfo Container. DG Signatory Information is used to
+	// print the Dangerous Goods paper work. Required if the shipment
+	// contains HazMat and DG paper work is requested.
+	DGSignatoryInfo *DGSignatoryInfo `json:",omitempty"`
 	// TODO: implement ShipmentRatingOptions
 
 	MovementReferenceNumber string           `json:",omitempty"`
 	ReferenceNumber         *ReferenceNumber `json:",omitempty"`
 	// UPS service type.
 	Service Service
+	// Return Service container. Its presence makes the shipment a return
+	// shipment. Not valid with COD and ImportControlIndicator.
+	ReturnService *ReturnService `json:",omitempty"`
 
-	// TODO: implement InvoiceLineTotal
+	// Container to hold InvoiceLineTotal Information. Required for forward
+	// shipments whose origin is the US and destination is Puerto Rico or
+	// Canada. Not available for any other shipments.
+	InvoiceLineTotal *InvoiceLineTotal `json:",omitempty"`
 
 	// Total number of pieces in all pallets in a UPS Worldwide Express
 	// Freight Shipment.
@@ -98,7 +115,9 @@ type Shipment struct {
 	// Valid values: 1 = Balloon 2 = Oversize 3 = Not Applicable
 	IrregularIndicator string `json:",omitempty" validate:"max=30"`
 
-	// TODO: implement ShipmentIndicationType
+	// Indicates the type of the shipment, e.g. Hold For Pickup at UPS
+	// Access Point or UPS Access Point Delivery.
+	ShipmentIndicationType *ShipmentIndicationType `json:",omitempty"`
 
 	// MIDualReturnShipmentKey is unique key required to process Mail
 	// Innovations Dual Return Shipment. The unique identifier (key) would be
@@ -323,6 +342,36 @@ type ShipToAddress struct {
 	ResidentialAddressIndicator string `json:",omitempty"`
 }
 
+type InvoiceLineTotal struct {
+	// Invoice Line Total Currency type. The Currency code should match the
+	// origin country's or territory's currency code, otherwise the currency
+	// code entered will be ignored.
+	CurrencyCode string `json:",omitempty" validate:"max=3"`
+	// Total amount of the invoice accompanying the shipment. Required when
+	// the InvoiceLineTotal container exists in the request. Valid values
+	// are from 1 to 99999999.
+	MonetaryValue string `validate:"min=1,max=11"`
+}
+
+type AlternateDeliveryAddress struct {
+	// Name of the UPS Access Point.
+	Name string `validate:"min=1,max=35"`
+	// Attention name of the UPS Access Point.
+	AttentionName string `json:",omitempty" validate:"max=35"`
+	// UPS Access Point ID, e.g. as chosen with the UPS Locator.
+	UPSAccessPointID string `json:",omitempty" validate:"max=9"`
+	// Address of the UPS Access Point.
+	Address AlternateDeliveryAddressAddress
+}
+
+type AlternateDeliveryAddressAddress struct {
+	AddressLines      []string `json:"AddressLine" validate:"required,max=3,dive,min=1,max=35"`
+	City              string   `validate:"min=1,max=30"`
+	StateProvinceCode string   `json:",omitempty" validate:"max=5"`
+	PostalCode        string   `validate:"max=9"`
+	CountryCode       string   `validate:"len=2"`
+}
+
 type ShipFrom struct {
 	// 35 characters are accepted, but for return Shipment only 30 characters will be printed on
 	// the label.
@@ -369,7 +418,21 @@ type TaxIDType struct {
 }
 
 type PaymentInformation struct {
+	// Shipment charge container.
+	// If Duty and Tax charges are applicable to a shipment and a payer is
+	// not specified, the default payer of Duty and Tax charges is Bill to
+	// Receiver. There will be no default payer of Duty and Tax charges for
+	// DDU and DDP service.
 	ShipmentCharge ShipmentCharge
+	// Further shipment charges, e.g. Duties and Taxes or Broker of Choice.
+	// They are sent together with ShipmentCharge as an array.
+	AdditionalShipmentCharges []ShipmentCharge `json:"-" validate:"max=2,dive"`
+	// Split Duty VAT Indicator. The presence indicates the payer specified
+	// for Transportation Charges will pay transportation charges and any
+	// duties that apply to the shipment. The payer specified for Duties and
+	// Taxes will pay the VAT (Value-Added Tax) only. The payer for
+	// Transportation charges and Duties and Taxes must be different.
+	SplitDutyVATIndicator string `json:",omitempty"`
 }
 
 type ShipmentCharge struct {
@@ -386,10 +449,31 @@ type ShipmentCharge struct {
 	// destination country or territory are both European Union countries and
 	// territories and the GoodsNotInFreeCirculation indicator is not present.
 	// 5) The origin and destination IATA code is the same.
-	Type        string       `validate:"len=2"`
+	Type string `validate:"len=2"`
+	// Container for the BillShipper billing option. This element or its
+	// sibling element, BillReceiver, BillThirdParty or
+	// ConsigneeBilledIndicator, must be present but no more than one can
+	// be present.
 	BillShipper *BillShipper `json:",omitempty"`
-	// TODO: implement BillReceiver
-	// TODO: implement BillThirdParty
+	// Container for the BillReceiver billing option. This element or its
+	// sibling element, BillShipper, BillThirdParty or
+	// ConsigneeBilledIndicator, must be present but no more than one can
+	// be present.
+	// For a return shipment, Bill Receiver is invalid for Transportation
+	// charges.
+	BillReceiver *BillReceiver `json:",omitempty"`
+	// Container for the third party billing option. This element or its
+	// sibling element, BillShipper, BillReceiver or Consignee Billed, must
+	// be present but no more than one can be present.
+	BillThirdParty *BillThirdParty `json:",omitempty"`
+	// Consignee Billing payment option indicator. The presence indicates
+	// consignee billing option is selected. The absence indicates one of
+	// the other payment options is selected.
+	// This element or its sibling element, BillShipper, BillReceiver or
+	// BillThirdParty, must be present but no more than one can be
+	// present. This element is only valid for shipment charge type
+	// Transportation and for US/PR to US/PR shipments.
+	ConsigneeBilledIndicator string `json:",omitempty"`
 }
 
 type BillShipper struct {
@@ -409,6 +493,68 @@ type BillShipper struct {
 	CreditCard *CreditCard `json:",omitempty"`
 }
 
+type BillReceiver struct {
+	// The UPS account number. The account must be a valid UPS account
+	// number that is active.
+	// For US, PR and CA accounts, the account must be either a daily
+	// pickup account, an occasional account, or a customer B.I.N account.
+	// Other accounts must be either a daily pickup account or an
+	// occasional account.
+	AccountNumber string `validate:"len=6"`
+	// Container for additional information for the bill receiver's UPS
+	// account's address.
+	Address *BillReceiverAddress `json:",omitempty"`
+}
+
+type BillReceiverAddress struct {
+	// The postal code for the UPS accounts pickup address. The pickup
+	// postal code is the one that was entered in the UPS system when the
+	// account was set-up.
+	PostalCode string `json:",omitempty" validate:"max=9"`
+}
+
+type BillThirdParty struct {
+	// The UPS account number of the third party shipper. The account must
+	// be a valid UPS account number that is active.
+	// For US, PR and CA accounts, the account must be either a daily
+	// pickup account, an occasional account, or a customer B.I.N account.
+	// Other accounts must be either a daily pickup account or an
+	// occasional account.
+	AccountNumber string `validate:"len=6"`
+	// Method for the third party to receive the invoice by email. Valid
+	// only for Poland.
+	CertifiedElectronicMail string `json:",omitempty" validate:"max=50"`
+	// VAT or tax ID of the third party. Valid only for Poland.
+	InterchangeSystemCode string `json:",omitempty" validate:"max=30"`
+	// Container for additional information for the third party UPS
+	// accounts address.
+	Address BillThirdPartyAddress
+}
+
+type BillThirdPartyAddress struct {
+	// The address of the third party. Up to three occurrences are allowed.
+	AddressLines []string `json:"AddressLine,omitempty" validate:"max=3,dive,min=1,max=35"`
+	// City of the third party.
+	City string `json:",omitempty" validate:"max=30"`
+	// State or province code of the third party.
+	StateProvinceCode string `json:",omitempty" validate:"max=5"`
+	// The postal code for the UPS accounts pickup address. The pickup
+	// postal code is the one that was entered in the UPS system when the
+	// account was set-up. The postal code must be the same as the UPS
+	// account pickup address postal code.
+	// Required for United States and Canadian UPS accounts and/or if the
+	// UPS account pickup address has a postal code. If the UPS account's
+	// pickup country or territory is US or Puerto Rico, the postal code is 5
+	// or 9 digits. The character '-' may be used to separate the first five
+	// digits and the last four digits. If the UPS account's pickup country
+	// or territory is CA, the postal code is 6 alphanumeric characters
+	// whose format is A#A#A# where A is an uppercase letter and # is a
+	// digit.
+	PostalCode string `json:",omitempty" validate:"max=9"`
+	// The country or territory code for the UPS accounts pickup address.
+	CountryCode string `validate:"len=2"`
+}
+
 type CreditCard struct {
 	// Valid values:
 	// 01 = American Express
@@ -468,6 +614,89 @@ type FRSPaymentInformationAddress struct {
 	CountryCode string `validate:"len=2"`
 }
 
+type FreightShipmentInformation struct {
+	// Freight density information container. Required if
+	// DensityEligibleIndicator is present.
+	FreightDensityInfo *FreightDensityInfo `json:",omitempty"`
+	// The presence of the tag indicates that the rate request is density
+	// based. For Density Based Rating (DBR), the customer must have DBR
+	// Contract Service.
+	DensityEligibleIndicator string `json:",omitempty"`
+}
+
+type FreightDensityInfo struct {
+	// Adjusted height indicator. If present, the height of the handling
+	// units is adjusted to AdjustedHeight.
+	AdjustedHeightIndicator string `json:",omitempty"`
+	// Container for the adjusted height. Required if
+	// AdjustedHeightIndicator is present.
+	AdjustedHeight *AdjustedHeight `json:",omitempty"`
+	// Handling Unit for Density based rating container.
+	HandlingUnits []HandlingUnit `json:"HandlingUnits"`
+}
+
+type AdjustedHeight struct {
+	// Adjusted height value.
+	Value string `validate:"min=1,max=3"`
+	// Unit of measurement container for the adjusted height.
+	UnitOfMeasurement FreightUnitOfMeasurement
+}
+
+type HandlingUnit struct {
+	// Handling unit quantity for density based rating.
+	Quantity string `validate:"min=1,max=4"`
+	// Handling unit type container.
+	Type HandlingUnitType
+	// Dimensions container of the handling unit.
+	Dimensions HandlingUnitDimensions
+}
+
+type HandlingUnitType struct {
+	// The code of the handling unit type. Valid values: SKD = Skid,
+	// CBY = Carboy, PLT = Pallet, TOT = Totes, LOO = Loose, OTH = Other.
+	Code string `validate:"len=3"`
+	// A description of the handling unit type.
+	Description string `json:",omitempty" validate:"max=35"`
+}
+
+type HandlingUnitDimensions struct {
+	// Unit of measurement container for the dimensions.
+	UnitOfMeasurement FreightUnitOfMeasurement
+	// The length of the handling unit.
+	Length string `validate:"min=1,max=3"`
+	// The width of the handling unit.
+	Width string `validate:"min=1,max=3"`
+	// The height of the handling unit.
+	Height string `validate:"min=1,max=3"`
+}
+
+type FreightUnitOfMeasurement struct {
+	// Code for the unit of measurement. Only IN = Inches is supported.
+	Code string `validate:"len=2"`
+	// Description for the unit of measurement.
+	Description string `json:",omitempty" validate:"max=35"`
+}
+
+type ReturnService struct {
+	// Return service type code. Valid values: 2 = UPS Print and Mail (PNM),
+	// 3 = UPS Return Service 1-Attempt (RS1), 5 = UPS Return Service
+	// 3-Attempt (RS3), 8 = UPS Electronic Return Label (ERL), 9 = UPS
+	// Print Return Label (PRL), 10 = UPS Exchange Print Return Label,
+	// 11 to 20 = UPS Pack & Collect Service 1-Attempt or 3-Attempt Box 1 to
+	// 5.
+	Code string `validate:"min=1,max=2"`
+	// Return service description.
+	Description string `json:",omitempty" validate:"max=35"`
+}
+
+type ShipmentIndicationType struct {
+	// Code for Shipment Indication Type. Valid values: 01 = Hold for
+	// Pickup at UPS Access Point, 02 = UPS Access Point Delivery.
+	Code string `validate:"len=2"`
+	// Description for Shipment Indication Type.
+	Description string `json:",omitempty" validate:"max=35"`
+}
+
 type ReferenceNumber struct {
 	// If the indicator is present then the reference number’s value will be bar
 	// coded on the label.
@@ -518,6 +747,7 @@ type Service struct {
 	// shipments
 	// The following Services are not available to return shipment: 13, 59, 82,
 	// 83, 84, 85, 86
+	// See ServiceCode for constants and availability.
 	Code string `json:",omitempty" validate:"len=2"`
 	// Description of the service code. Examples are Next Day Air, Worldwide
 	// Express, and Ground.
@@ -533,8 +763,15 @@ type ShipmentServiceOptions struct {
 	// requested and the absence indicates Saturday pickup is not requested.
 	SaturdayPickupIndicator string `json:",omitempty"`
 
-	// TODO: implement COD
-	// TODO: implement AccessPointCOD
+	// If present, indicates C.O.D. is requested for the shipment.
+	// Shipment level C.O.D. is not valid for US/PR to US/PR, CA to CA and
+	// CA to US shipments, they require package level C.O.D..
+	COD *COD `json:",omitempty"`
+	// Access Point COD indicates COD is requested for a shipment.
+	// Valid only for "01 - Hold For Pickup At UPS Access Point" Shipment
+	// Indication type. Shipment Access Point COD is valid only for
+	// countries or territories within E.U. Not valid with COD.
+	AccessPointCOD *AccessPointCOD `json:",omitempty"`
 
 	// Presence/Absence Indicator. Any value inside is ignored.
 	// DeliverToAddresseeOnlyIndicator is shipper specified restriction that
@@ -559,51 +796,12 @@ type ShipmentServiceOptions struct {
 	// international shipments as well as for domestic shipments (for US and
 	// PR).
 	Notifications []Notification `json:"Notification,omitempty" validate:"max=3,dive"`
-}
-
-type Notification struct {
-	// The type of notification requested. Note: - QVN Exception notification
-	// and return notification are not applicable to GFP. - QV In-transit and
-	// Return Notifications are only valid for ImportControl and Return
-	// shipment. - QV In-transit Notification is allowed for return shipments
-	// only. - QV Ship Notification is allowed for forward moving shipments
-	// only.
-	// Valid values: 5 - QV In-transit Notification 6 - QV Ship Notification 7 -
-	// QV Exception Notification 8 - QV Delivery Notification 2 - Return
-	// Notification or Label Creation Notification 012 - Alternate Delivery
-	// Location Notification 013 - UAP Shipper Notification.
-	NotificationCode string `validate:"min=1,max=3"`
-	EMail            EMail
-}
 
-type EMail struct {
-	// Email address where the notification is sent.
-	// Up to five email addresses are allowed for each type of Quantum View
-	// TM shipment notification. Up to two email address for return notification
-	EMailAddresses []string `json:"EMailAddress" validate:"required,min=1,max=5,dive,min=1,max=50"`
-	// The address where an undeliverable eMail message is sent if the eMail
-	// with the notification is undeliverable.
-	// There can be only one UndeliverableEMailAddress for each type of
-	// Quantum View Shipment Notifications.
-	UndeliverableEMailAddress string `json:",omitempty" validate:"max=50"`
-	// The e-mail address specifies the Reply To E-mail address. The "From"
-	// field of the message header contains pkginfo@ups.com.
-	// Valid for Return Notification only.
-	FromEMailAddress string `json:",omitempty" validate:"max=50"`
-	// The name the email will appear to be from. Defaults to the Shipper Name.
-	// The FromName must occur only once for each shipment with Quantum
-	// View Shipment Notifications.
-	FromName string `json:",omitempty" validate:"max=35"`
-	// User defined text that will be included in the eMail.
-	// The Memo must occur only once for each shipment with Quantum View
-	// Shipment Notifications.
-	Memo string `json:",omitempty" validate:"max=150"`
-
-	// TODO: implement VoiceMessage
-	// TODO: implement TextMessage
-	// TODO: implement Locale
-	// TODO: implement LabelDelivery
-	// TODO: implement InternationalForms
+	// Container for the label delivery by e-mail or as link. Required for
+	// Electronic Return Label and Electronic Import Control Label shipments.
+	LabelDelivery *LabelDelivery `json:",omitempty"`
+	// International Forms information container.
+	InternationalForms *InternationalForms `json:",omitempty"`
 	// TODO: implement DeliveryConfirmation
 
 	// The flag indicates the ReturnOfDocument accessorial has been
@@ -613,14 +811,17 @@ type EMail struct {
 	// Indicates that the Shipment is an ImportControl shipment.
 	ImportControlIndicator string `json:",omitempty"`
 
-	// TODO: implement LabelMethod
+	// Label method container. Required for ImportControl shipments.
+	LabelMethod *LabelMethod `json:",omitempty"`
 
 	// CommercialInvoiceRemovalIndicator allows a shipper to dictate UPS to
 	// remove the Commercial Invoice from the user's shipment before the
 	// shipment is delivered to the ultimate consignee.
 	CommercialInvoiceRemovalIndicator string `json:",omitempty"`
 
-	// TODO: implement PreAlertNotification
+	// Pre-alert notifications sent before an international shipment
+	// arrives.
+	PreAlertNotifications []PreAlertNotification `json:"PreAlertNotification,omitempty" validate:"max=3,dive"`
 
 	// Exchange forward indicator presence at shipment level is required to
 	// create exchange forward Shipments.
@@ -672,7 +873,312 @@ type EMail struct {
 	// numbers.
 	EPRAReleaseCode string `json:",omitempty" validate:"max=6"`
 
-	// TODO: implement RestrictedArticles
+	// Restricted Articles container. The shipper has to declare articles
+	// which are restricted by UPS.
+	RestrictedArticles *RestrictedArticles `json:",omitempty"`
+}
+
+type COD struct {
+	// For shipment level COD refer to: Rating and Shipping COD Supported
+	// Countries or Territories in the Appendix for valid values.
+	// Package level valid values: 0 = Check, Cashier's Check or Money
+	// Order - no cash allowed, 8 = Cashier's Check or Money Order - no cash
+	// allowed.
+	CODFundsCode string `validate:"len=1"`
+	// COD Amount container.
+	CODAmount CODAmount
+}
+
+type CODAmount struct {
+	// COD amount currency code type. Has to be the currency of the
+	// destination country or territory.
+	CurrencyCode string `validate:"len=3"`
+	// COD Amount monetary value. Up to 2 decimal places are allowed.
+	MonetaryValue string `validate:"min=1,max=8"`
+}
+
+type AccessPointCOD struct {
+	// Access Point COD Currency Code.
+	// Required if Access Point COD container is present. UPS does not
+	// support all international currency codes. Refer to the appendix for a
+	// list of valid codes.
+	CurrencyCode string `validate:"len=3"`
+	// Access Point COD Monetary Value.
+	// Required if Access Point COD container is present.
+	// 8 digits prior to the decimal place and 2 after.
+	MonetaryValue string `validate:"min=1,max=11"`
+}
+
+type Notification struct {
+	// The type of notification requested. Note: - QVN Exception notification
+	// and return notification are not applicable to GFP. - QV In-transit and
+	// Return Notifications are only valid for ImportControl and Return
+	// shipment. - QV In-transit Notification is allowed for return shipments
+	// only. - QV Ship Notification is allowed for forward moving shipments
+	// only.
+	// Valid values: 5 - QV In-transit Notification 6 - QV Ship Notification 7 -
+	// QV Exception Notification 8 - QV Delivery Notification 2 - Return
+	// Notification or Label Creation Notification 012 - Alternate Delivery
+	// Location Notification 013 - UAP Shipper Notification.
+	NotificationCode string `validate:"min=1,max=3"`
+	// Container for the e-mail message. It is omitted if it is empty, e.g.
+	// for notifications with a voice or text message only.
+	EMail EMail
+	// Container for the voice message. Valid for notification codes 012
+	// and 013 only.
+	VoiceMessage *NotificationPhone `json:",omitempty"`
+	// Container for the text message. Valid for notification codes 012 and
+	// 013 only.
+	TextMessage *NotificationPhone `json:",omitempty"`
+	// Container for the language and dialect of the notification. Required
+	// for notification codes 012 and 013 and for voice and text messages.
+	Locale *Locale `json:",omitempty"`
+}
+
+// EMail is the e-mail container of a Notification.
+//
+// ReturnOfDocumentIndicator, ImportControlIndicator,
+// CommercialInvoiceRemovalIndicator, ExchangeForwardIndicator,
+// HoldForPickupIndicator, DropoffAtUPSFacilityIndicator,
+// LiftGateForPickUpIndicator, LiftGateForDeliveryIndicator,
+// SDLShipmentIndicator and EPRAReleaseCode used to be fields of EMail, which
+// sent them inside the notification. They are fields of
+// ShipmentServiceOptions, where UPS expects them.
+type EMail struct {
+	// Email address where the notification is sent.
+	// Up to five email addresses are allowed for each type of Quantum View
+	// TM shipment notification. Up to two email address for return notification
+	EMailAddresses []string `json:"EMailAddress" validate:"required,min=1,max=5,dive,min=1,max=50"`
+	// The address where an undeliverable eMail message is sent if the eMail
+	// with the notification is undeliverable.
+	// There can be only one UndeliverableEMailAddress for each type of
+	// Quantum View Shipment Notifications.
+	UndeliverableEMailAddress string `json:",omitempty" validate:"max=50"`
+	// The e-mail address specifies the Reply To E-mail address. The "From"
+	// field of the message header contains pkginfo@ups.com.
+	// Valid for Return Notification only.
+	FromEMailAddress string `json:",omitempty" validate:"max=50"`
+	// The name the email will appear to be from. Defaults to the Shipper Name.
+	// The FromName must occur only once for each shipment with Quantum
+	// View Shipment Notifications.
+	FromName string `json:",omitempty" validate:"max=35"`
+	// User defined text that will be included in the eMail.
+	// The Memo must occur only once for each shipment with Quantum View
+	// Shipment Notifications.
+	Memo string `json:",omitempty" validate:"max=150"`
+}
+
+type RestrictedArticles struct {
+	// This field is a flag to indicate if the package has Diagnostic
+	// Specimens. True if present.
+	DiagnosticSpecimensIndicator string `json:",omitempty"`
+	// This field is a flag to indicate if the package has Alcohol. True if
+	// present. Valid for UPS World Wide Express Freight shipments.
+	AlcoholicBeveragesIndicator string `json:",omitempty"`
+	// This field is a flag to indicate if the package has Perishable items.
+	// True if present.
+	PerishablesIndicator string `json:",omitempty"`
+	// This field is a flag to indicate if the package has Plants. True if
+	// present.
+	PlantsIndicator string `json:",omitempty"`
+	// This field is a flag to indicate if the package has Seeds. True if
+	// present.
+	SeedsIndicator string `json:",omitempty"`
+	// This field is a flag to indicate if the package has Special
+	// Exceptions Restricted Materials. True if present.
+	SpecialExceptionsIndicator string `json:",omitempty"`
+	// This field is a flag to indicate if the package has Tobacco. True if
+	// present.
+	TobaccoIndicator string `json:",omitempty"`
+	// This field is a flag to indicate if the package has E-Cigarettes.
+	// True if present.
+	ECigarettesIndicator string `json:",omitempty"`
+	// This field is a flag to indicate if the package has Hemp/CBD. True
+	// if present.
+	HempCBDIndicator string `json:",omitempty"`
+}
+
+type DGSignatoryInfo struct {
+	// Name of the signatory.
+	Name string `validate:"max=100"`
+	// Title of the signatory.
+	Title string `validate:"max=100"`
+	// Place of the signatory.
+	Place string `validate:"max=100"`
+	// Date of signature. Format: YYYYMMDD.
+	Date string `validate:"len=8"`
+	// Shipper Declaration.
+	// Valid values: 01 = Shipment level, 02 = Package level.
+	ShipperDeclaration string `json:",omitempty" validate:"max=2"`
+	// Presence/Absence Indicator. Any value inside is ignored. The DG
+	// paper work is uploaded only and not returned in the response.
+	UploadOnlyIndicator string `json:",omitempty"`
+}
+
+type InternationalForms struct {
+	// Indicates the name of the International Form requested. Valid
+	// values: 01 = Invoice, 03 = CO, 04 = NAFTA CO, 05 = Partial Invoice,
+	// 06 = Packinglist, 07 = Customer Generated Forms, 08 = Air Freight
+	// Packing List, 09 = CN22 Form, 10 = UPS Premium Care Form, 11 = EEI.
+	// For shipment with return service, 01, 05 or 10 are the only valid
+	// values. Note: 01 and 05 are mutually exclusive and 05 are only valid
+	// for return shipments only.
+	FormTypes []string `json:"FormType" validate:"required,min=1,max=6,dive,len=2"`
+	// Invoice number. Applies to Invoice and Partial Invoice forms only.
+	InvoiceNumber string `json:",omitempty" validate:"max=35"`
+	// Date the invoice was created. Format: YYYYMMDD. Required for
+	// Invoice forms and optional for Partial Invoice.
+	InvoiceDate string `json:",omitempty" validate:"max=8"`
+	// Customer's order reference number. Applies to Invoice and Partial
+	// Invoice forms only.
+	PurchaseOrderNumber string `json:",omitempty" validate:"max=35"`
+	// Terms of shipment, e.g. CFR, CIF, DDP, DAP, EXW, FCA, FOB. Applies to
+	// Invoice and Partial Invoice forms only.
+	TermsOfShipment string `json:",omitempty" validate:"max=3"`
+	// A reason to export the current international shipment. Valid values:
+	// SALE, GIFT, SAMPLE, RETURN, REPAIR, INTERCOMPANYDATA. Required for
+	// Invoice forms and optional for Partial Invoice.
+	ReasonForExport string `json:",omitempty" validate:"max=20"`
+	// Any extra information about the current shipment. Applies to Invoice
+	// and Partial Invoice forms only.
+	Comments string `json:",omitempty" validate:"max=150"`
+	// Your standard declaration statement. Applies to Invoice and Partial
+	// Invoice forms only.
+	DeclarationStatement string `json:",omitempty" validate:"max=550"`
+	// Currency code for all the monetary values of the International
+	// Forms. Required for Invoice forms.
+	CurrencyCode string `json:",omitempty" validate:"max=3"`
+	// Contains the commodity information of the products in the shipment.
+	// Up to 50 products are allowed.
+	Products []Product `json:"Product,omitempty" validate:"max=50,dive"`
+
+	// TODO: implement UserCreatedForm, UPSPremiumCareForm, CN22Form,
+	// Contacts, Discount, FreightCharges, InsuranceCharges, OtherCharges
+	// and the EEI filing options
+}
+
+type Product struct {
+	// Description of the product. Up to three occurrences are allowed.
+	Descriptions []string `json:"Description" validate:"required,max=3,dive,max=35"`
+	// Unit information container. Required for Invoice forms.
+	Unit *ProductUnit `json:",omitempty"`
+	// 6-to-15-alphanumeric commodity code. Customs uses this code to
+	// determine what duties should be assessed on the commodity.
+	CommodityCode string `json:",omitempty" validate:"max=15"`
+	// The part number or reference number for the product contained on
+	// the invoice line item.
+	PartNumber string `json:",omitempty" validate:"max=10"`
+	// The country or territory in which the good was manufactured,
+	// produced or grown. Required for Invoice forms.
+	OriginCountryCode string `json:",omitempty" validate:"max=2"`
+	// Weight of the product. Applies to CO and NAFTA CO forms.
+	ProductWeight *ProductWeight `json:",omitempty"`
+}
+
+type ProductUnit struct {
+	// Total quantity of each commodity to be shipped, measured in the
+	// units specified in UnitOfMeasurement. Valid characters are 0-9 and
+	// up to 6 decimal places.
+	Number string `validate:"min=1,max=15"`
+	// Container for the unit of measurement.
+	UnitOfMeasurement ProductUnitOfMeasurement
+	// Monetary amount used to specify the worth or price of the commodity.
+	// Amount should be greater than zero. Up to 6 decimal places.
+	Value string `validate:"min=1,max=19"`
+}
+
+type ProductUnitOfMeasurement struct {
+	// Code for the Unit of measurement for the commodity, e.g. PCS = Pieces,
+	// BOX = Box, KG = Kilogram.
+	Code string `validate:"min=1,max=5"`
+	// Description of the unit of measurement. Required if Code is OTH.
+	Description string `json:",omitempty" validate:"max=35"`
+}
+
+type ProductWeight struct {
+	// Container for the unit of measurement of the product weight.
+	UnitOfMeasurement ProductWeightUnitOfMeasurement
+	// Weight of the product. Up to 1 decimal place.
+	Weight string `validate:"min=1,max=5"`
+}
+
+type ProductWeightUnitOfMeasurement struct {
+	// Code for the unit of measurement of the product weight. Valid values:
+	// LBS, KGS.
+	Code string `validate:"len=3"`
+	// Description of the unit of measurement.
+	Description string `json:",omitempty" validate:"max=35"`
+}
+
+type NotificationPhone struct {
+	// Phone number receiving the message. For US and CA 10 digits are
+	// required.
+	PhoneNumber string `validate:"min=1,max=15"`
+}
+
+type Locale struct {
+	// Language of the notification, e.g. ENG, DEU, FRA or SPA.
+	Language string `validate:"len=3"`
+	// Dialect of the language, e.g. US, GB, CA or 97 for languages without
+	// dialect.
+	Dialect string `validate:"len=2"`
+}
+
+type LabelDelivery struct {
+	// Container for the e-mail with the label.
+	EMail *LabelDeliveryEMail `json:",omitempty"`
+	// Presence/Absence indicator. If present, the URL to retrieve the label
+	// and receipt is returned in ShipmentResults.
+	LabelLinksIndicator string `json:",omitempty"`
+}
+
+type LabelDeliveryEMail struct {
+	// The destination email address of the label.
+	EMailAddress string `validate:"min=1,max=50"`
+	// The address where an undeliverable eMail message is sent.
+	UndeliverableEMailAddress string `json:",omitempty" validate:"max=50"`
+	// The Reply To e-mail address.
+	FromEMailAddress string `json:",omitempty" validate:"max=50"`
+	// The name the email will appear to be from. Defaults to the Shipper
+	// Name.
+	FromName string `json:",omitempty" validate:"max=35"`
+	// User defined text that will be included in the eMail.
+	Memo string `json:",omitempty" validate:"max=150"`
+	// User defined subject of the eMail.
+	Subject string `json:",omitempty" validate:"max=50"`
+	// Specifies the reference number used as subject. Valid values: 01 =
+	// Shipment Reference Number 1, 02 = Shipment Reference Number 2, 03 =
+	// Package Reference Number 1 up to 07, 08 = Subject Text, 09 = Tracking
+	// Number.
+	SubjectCode string `json:",omitempty" validate:"max=2"`
+}
+
+type LabelMethod struct {
+	// Type of ImportControl label. Valid values: 01 = ImportControl Print
+	// and Mail, 02 = ImportControl One-Attempt, 03 = ImportControl
+	// Three-Attempt, 04 = ImportControl Electronic Label, 05 =
+	// ImportControl Print Label.
+	Code string `validate:"len=2"`
+	// Description of the label method.
+	Description string `json:",omitempty" validate:"max=35"`
+}
+
+type PreAlertNotification struct {
+	// Container for the e-mail message.
+	EMailMessage *PreAlertEMailMessage `json:",omitempty"`
+	// Container for the voice message.
+	VoiceMessage *NotificationPhone `json:",omitempty"`
+	// Container for the text message.
+	TextMessage *NotificationPhone `json:",omitempty"`
+	// Container for the language and dialect of the notification.
+	Locale Locale
+}
+
+type PreAlertEMailMessage struct {
+	// Email address where the notification is sent.
+	EMailAddress string `validate:"min=1,max=50"`
+	// The address where an undeliverable eMail message is sent.
+	UndeliverableEMailAddress string `json:",omitempty" validate:"max=50"`
 }
 
 type Package struct {
@@ -682,10 +1188,12 @@ type Package struct {
 	// Description of articles & special marks. Applicable for Air Freight only
 	PalletDescription string `json:",omitempty" validate:"max=150"`
 	// Number of Pieces. Applicable for Air Freight only
+	// Use FormatNumOfPieces to format it.
 	NumOfPieces string `json:",omitempty" validate:"max=5"`
 	// Unit price of the commodity. Applicable for Air Freight only
 	// Limit to 2 digit after the decimal. The maximum length of the field is 12
 	// including ‘.’ and can hold up to 2 decimal place. (e.g. 999999999.99)
+	// Use FormatUnitPrice to format it.
 	UnitPrice string `json:",omitempty" validate:"max=12"`
 	// Packaging container.
 	// Container for Packaging Type.
@@ -726,10 +1234,267 @@ type Package struct {
 	// Applicable for UPS Worldwide Economy DDU service.
 	MinimumBillableWeightIndicator string `json:",omitempty"`
 
-	// TODO: implement PackageServiceOptions
-	// TODO: implement Commodity
-	// TODO: implement HazMatPackageInformation
-	// TODO: implement SimpleRate
+	// Package Service Options container.
+	PackageServiceOptions *PackageServiceOptions `json:",omitempty"`
+
+	// Commodity Information container. Required for Ground Freight
+	// Pricing shipments only.
+	Commodity *Commodity `json:",omitempty"`
+	// Container to hold HazMat Package Information. Applies to packages
+	// containing dangerous goods with more than one chemical record.
+	HazMatPackageInformation *HazMatPackageInformation `json:",omitempty"`
+	// SimpleRate Container. UPS Simple Rate prices domestic packages by
+	// their size instead of weight and distance.
+	SimpleRate *SimpleRate `json:",omitempty"`
+}
+
+type SimpleRate struct {
+	// SimpleRate code. Valid values: XS = Extra Small, S = Small, M =
+	// Medium, L = Large, XL = Extra Large.
+	Code string `validate:"min=1,max=2"`
+	// SimpleRate description.
+	Description string `json:",omitempty" validate:"max=50"`
+}
+
+type Commodity struct {
+	// Freight Classification. Freight class partially determines the
+	// freight rate for the article. Valid values: 50, 55, 60, 65, 70, 77.5,
+	// 85, 92.5, 100, 110, 125, 150, 175, 200, 250, 300, 400, 500.
+	FreightClass string `validate:"max=10"`
+	// Container for National Motor Freight Classification numbers.
+	NMFC *NMFC `json:",omitempty"`
+}
+
+type NMFC struct {
+	// Specifies the Prime Code of the commodity.
+	PrimeCode string `validate:"min=4,max=6"`
+	// Specifies the Sub Code of the commodity.
+	SubCode string `json:",omitempty" validate:"max=2"`
+}
+
+type PackageServiceOptions struct {
+	// Delivery Confirmation container. Valid only for forward shipments.
+	DeliveryConfirmation *DeliveryConfirmation `json:",omitempty"`
+	// Declared value container. Used to insure packages with a value above
+	// the UPS default liability.
+	DeclaredValue *DeclaredValue `json:",omitempty"`
+	// Indicates COD is requested for the package. Package level COD is
+	// only valid for US/PR to US/PR, CA to CA and CA to US shipments.
+	COD *COD `json:",omitempty"`
+	// Access Point COD indicates Package COD is requested for a shipment.
+	// Valid only for "01 - Hold For Pickup At UPS Access Point" Shipment
+	// Indication type. Package Access Point COD is valid only for shipment
+	// without return service from US/PR to US/PR and CA to CA. Not valid
+	// with COD.
+	AccessPointCOD *AccessPointCOD `json:",omitempty"`
+	// The presence indicates that the package may be released by driver
+	// without a signature from the consignee. Empty Tag. Only available for
+	// US50/PR to US50/PR packages without return service.
+	ShipperReleaseIndicator string `json:",omitempty"`
+	// Receiver Return Notification. Applicable for Package Returns Flexible
+	// Access (RFA) only.
+	Notification *Notification `json:",omitempty"`
+	// Container to hold HazMat information. Up to three chemical records
+	// are allowed per package.
+	// Applies only if SubVersion is greater than or equal to 1701.
+	HazMats []HazMat `json:"HazMat,omitempty" validate:"max=3,dive"`
+	// Container for Dry Ice.
+	DryIce *DryIce `json:",omitempty"`
+	// Container for Verbal Confirmation. The contact is called when the
+	// package was delivered.
+	VerbalConfirmation *VerbalConfirmation `json:",omitempty"`
+	// The UPSPremiumCareIndicator indicates special handling is required
+	// for shipment having controlled substances. Empty Tag means indicator
+	// is present.
+	// Valid only for Canada to Canada movements.
+	// Available for the following Return Services: Returns Exchange
+	// (available with a contract), Print Return Label, Print and Mail, Electronic
+	// Return Label, Return Service Three Attempt.
+	// May be requested with following UPS services: UPS Express Early,
+	// UPS Express, UPS Express Saver, UPS Standard.
+	UPSPremiumCareIndicator string `json:",omitempty"`
+	// Presence/Absence Indicator. Any value inside is ignored. Indicates
+	// UPS Proactive Response is requested. UPS monitors the package and
+	// intervenes proactively if it is delayed.
+	ProactiveIndicator string `json:",omitempty"`
+	// Identifies the package containing Dangerous Goods. Required if
+	// SubVersion is 1701 or greater and the package contains Dangerous
+	// Goods.
+	PackageIdentifier string `json:",omitempty" validate:"max=5"`
+	// Unique identifier for clinical trials.
+	ClinicalTrialsID string `json:",omitempty" validate:"max=20"`
+	// Presence/Absence Indicator. Any value inside is ignored. Indicates
+	// the package requires refrigeration.
+	RefrigerationIndicator string `json:",omitempty"`
+}
+
+type HazMat struct {
+	// Identifies the Chemical Record. Required if SubVersion is greater
+	// than or equal to 1701.
+	ChemicalRecordIdentifier string `json:",omitempty" validate:"max=3"`
+	// The number of pieces of the specific commodity. Required if
+	// CommodityRegulatedLevelCode = LQ or FR. Valid values: 1 to 999.
+	PackagingTypeQuantity string `json:",omitempty" validate:"max=3"`
+	// Secondary hazardous characteristics of a package. There can be more
+	// than one, separated by a comma.
+	SubRiskClass string `json:",omitempty" validate:"max=7"`
+	// The ADR item number, required for ADR shipments.
+	ADRItemNumber string `json:"aDRItemNumber,omitempty" validate:"max=10"`
+	// The ADR packing group letter, required for ADR shipments.
+	ADRPackingGroupLetter string `json:"aDRPackingGroupLetter,omitempty" validate:"max=10"`
+	// The technical name (when required) for the specified commodity.
+	TechnicalName string `json:",omitempty" validate:"max=200"`
+	// Defines the type of label that is required on the package for the
+	// commodity. Not applicable if CommodityRegulatedLevelCode = LR or EQ.
+	HazardLabelRequired string `json:",omitempty" validate:"max=50"`
+	// This is the hazard class associated to the specified commodity.
+	// Required if CommodityRegulatedLevelCode is LQ or FR.
+	ClassDivisionNumber string `json:",omitempty" validate:"max=7"`
+	// Reference number.
+	ReferenceNumber string `json:",omitempty" validate:"max=35"`
+	// This is the numerical value of the mass capacity of the regulated
+	// good.
+	Quantity string `json:",omitempty" validate:"max=15"`
+	// The unit of measure used for the mass capacity of the regulated
+	// good. For Example: ml, L, g, mg, kg, cylinder, pound, pint, quart,
+	// gallon, ounce etc.
+	UOM string `json:",omitempty" validate:"max=10"`
+	// The type of package used to contain the regulated good. (Ex: Fiberboard
+	// Box, Aluminum Box, Plastic Drum, Wooden Box, etc.).
+	PackagingType string `json:",omitempty" validate:"max=255"`
+	// This is the ID number (UN/NA/ID) for the specified commodity, e.g.
+	// UN3481. UN/NA/ID Identification Number assigned to the specified
+	// regulated good.
+	IDNumber string `json:",omitempty" validate:"max=6"`
+	// The Proper Shipping Name assigned by ADR, CFR or IATA.
+	ProperShippingName string `json:",omitempty" validate:"max=250"`
+	// Additional remarks or special provision information.
+	AdditionalDescription string `json:",omitempty" validate:"max=75"`
+	// This is the packing group category associated to the specified
+	// commodity. Valid values: I, II, III, blank.
+	PackagingGroupType string `json:",omitempty" validate:"max=5"`
+	// The packing instructions related to the chemical record.
+	PackagingInstructionCode string `json:",omitempty" validate:"max=353"`
+	// 24 Hour Emergency Phone Number of the shipper. Valid values: 0-9.
+	EmergencyPhone string `json:",omitempty" validate:"max=25"`
+	// The emergency information, contact name and/or contract number,
+	// required to be communicated when a call is placed to the
+	// EmergencyPhoneNumber.
+	EmergencyContact string `json:",omitempty" validate:"max=35"`
+	// Recommended if CommodityRegulatedLevelCode = FR and the shipment
+	// is under CFR. Valid values: RQ, "" or null.
+	ReportableQuantity string `json:",omitempty" validate:"max=2"`
+	// The Regulatory set associated with every regulated shipment. It
+	// must be the same across the shipment.
+	// Valid values: ADR = Europe to Europe Ground Movement, CFR = HazMat
+	// regulated by US Dept of Transportation within the U.S. or ground
+	// shipments to Canada, IATA = Worldwide Air movement, TDG = Canada
+	// to Canada ground movement or Canada to U.S. standard movement.
+	RegulationSet string `validate:"min=3,max=4"`
+	// Declaration statement to be sent to the carrier. Valid values: Highway,
+	// Ground, PAX, Passenger Aircraft, CAO, Cargo Aircraft Only.
+	// GND, CAO, PAX are the values used in requests.
+	TransportationMode string `validate:"min=1,max=30"`
+	// Indicates the type of commodity: Fully Regulated (FR), Limited
+	// Quantity (LQ), Excepted Quantity (EQ) or Lightly Regulated (LR).
+	// Valid values: LR, FR, LQ, EQ.
+	CommodityRegulatedLevelCode string `validate:"len=2"`
+	// Transport Category. Valid values: 0 to 4.
+	TransportCategory string `json:",omitempty" validate:"max=1"`
+	// Defines what is restricted to pass through a tunnel.
+	TunnelRestrictionCode string `json:",omitempty" validate:"max=10"`
+	// The Local Technical Name, in the language of the origin country or
+	// territory.
+	LocalTechnicalName string `json:",omitempty" validate:"max=200"`
+	// The Local Proper Shipping Name, in the language of the origin
+	// country or territory.
+	LocalProperShippingName string `json:",omitempty" validate:"max=250"`
+}
+
+type HazMatPackageInformation struct {
+	// Presence/Absence indicator. Any value inside is ignored. Indicates
+	// the hazmat shipment/package is all packed in one.
+	AllPackedInOneIndicator string `json:",omitempty"`
+	// Presence/Absence indicator. Any value inside is ignored. Indicates
+	// that the package is over packed.
+	OverPackedIndicator string `json:",omitempty"`
+	// When a HazMat shipment specifies AllPackedInOneIndicator and the
+	// regulation set for that shipment is IATA, Ship API must require the
+	// shipment to specify a Q-Value with exactly one of the following
+	// values: 0.1; 0.2; 0.3; 0.4; 0.5; 0.6; 0.7; 0.8; 0.9; 1.0.
+	QValue string `json:",omitempty" validate:"max=3"`
+	// This field is used for the outer packaging type of the package.
+	OuterPackagingType string `json:",omitempty" validate:"max=255"`
+}
+
+type DeliveryConfirmation struct {
+	// Type of delivery confirmation. Valid values:
+	// 2 = Delivery Confirmation Signature Required
+	// 3 = Delivery Confirmation Adult Signature Required
+	DCISType string `validate:"len=1"`
+	// DCIS Number.
+	DCISNumber string `json:",omitempty" validate:"max=11"`
+}
+
+type DeclaredValue struct {
+	// Declared value type container. Defaults to 01 = EVS if missing.
+	Type *DeclaredValueType `json:",omitempty"`
+	// The IATA currency code associated with the declared value amount for
+	// the package. Must match the currency of the shipment.
+	CurrencyCode string `validate:"len=3"`
+	// The monetary value for the declared value amount associated with the
+	// package. Max value of 5,000 USD for Local and 50,000 USD for Remote.
+	MonetaryValue string `validate:"min=1,max=19"`
+}
+
+type DeclaredValueType struct {
+	// Declared value type code. Valid values: 01 = EVS, 02 = DVS.
+	Code string `validate:"len=2"`
+	// Description of the declared value type.
+	Description string `json:",omitempty" validate:"max=50"`
+}
+
+type DryIce struct {
+	// Regulation set for dry ice shipments. Valid values: CFR = For HazMat
+	// regulated by US Dept of Transportation within the U.S. or ground
+	// shipments to Canada, IATA = For Worldwide Air movement.
+	// The following values are valid: IATA and CFR.
+	RegulationSet string `validate:"min=3,max=4"`
+	// Container for dry ice weight.
+	DryIceWeight DryIceWeight
+	// Presence/Absence Indicator. Any value inside is ignored. Relevant
+	// only in CFR regulation set. If present it is used to designate the
+	// Dry Ice is for any medical use and rates are adjusted for DryIce
+	// weight more than 2.5 KGS or 5.5 LBS.
+	MedicalUseIndicator string `json:",omitempty"`
+}
+
+type DryIceWeight struct {
+	// Container for the unit of measurement of the dry ice weight.
+	UnitOfMeasurement DryIceWeightUnitOfMeasurement
+	// Weight of the dry ice. Format: 999.9.
+	Weight string `validate:"min=1,max=5"`
+}
+
+type DryIceWeightUnitOfMeasurement struct {
+	// Dry ice weight unit of measurement code. Valid values:
+	// 00 = KG (Metric Unit of Measurements) or KGS
+	// 01 = LB (English Unit of Measurements) or LBS
+	Code string `validate:"min=2,max=3"`
+	// Description of the unit of measurement.
+	Description string `json:",omitempty" validate:"max=35"`
+}
+
+type VerbalConfirmation struct {
+	// Contact information container for verbal confirmation.
+	ContactInfo ContactInfo
+}
+
+type ContactInfo struct {
+	// Name of the contact person.
+	Name string `json:",omitempty" validate:"max=35"`
+	// Phone number of the contact person.
+	Phone *Phone `json:",omitempty"`
 }
 
 type Packaging struct {
@@ -754,6 +1519,7 @@ type Packaging struct {
 	// 65 = Media Mail
 	// 66 = BPM Flat
 	// 67 = Standard Flat.
+	// See PackagingCode for constants.
 	// Note: Only packaging type code 02 is applicable to Ground Freight
 	// Pricing.
 	// Package type 24, or 25 is only allowed for shipment without return
@@ -774,6 +1540,7 @@ type Dimensions struct {
 	UnitOfMeasurement DimensionsUnitOfMeasurement
 	// Length must be the longest dimension of the container.
 	// Valid values are 0 to 108 IN and 0 to 274 CM.
+	// Use DimensionsIN or DimensionsCM to format the dimensions.
 	Length string `validate:"min=1,max=3"`
 	Width  string `validate:"min=1,max=3"`
 	Height string `validate:"min=1,max=3"`
@@ -792,7 +1559,9 @@ type DimensionsUnitOfMeasurement struct {
 
 type DimWeight struct {
 	UnitOfMeasurement DimWeightUnitOfMeasurement
-	Weight            string `validate:"len=6"`
+	// Weight with one implied decimal place. Use DimWeightLBS or
+	// DimWeightKG to format it.
+	Weight string `validate:"len=6"`
 }
 
 type DimWeightUnitOfMeasurement struct {
@@ -812,6 +1581,7 @@ type PackageWeight struct {
 	// Packages weight. Weight accepted for letters/envelopes.
 	// Only average package weight is required for Ground Freight Pricing
 	// Shipment.
+	// Use WeightLBS or WeightKG to format it.
 	Weight string `validate:"max=5"`
 }
 
@@ -826,7 +1596,7 @@ type PackageWeightUnitOfMeasurement struct {
 	// Please refer to Appendix for more details regarding the valid
 	// combination of Mail Innovation Forward Shipment services, Package
 	// Type and Unit of Measurement.
-	Code string `validate:"len=2"`
+	Code string `validate:"len=3"`
 	// Description of the unit of measurement for package weight.
 	Description string `json:",omitempty" validate:"max=35"`
 }
@@ -856,6 +1626,19 @@ type LabelSpecification struct {
 	CharacterSet string `json:",omitempty"`
 }
 
+type ReceiptSpecification struct {
+	// Shipment receipt image format container.
+	ImageFormat ReceiptImageFormat
+}
+
+type ReceiptImageFormat struct {
+	// Receipt image format code. Valid values: EPL = EPL2, SPL = SPL, ZPL
+	// = ZPL, STARPL = Star Printer, HTML = HTML.
+	Code string `validate:"min=1,max=6"`
+	// Description of the receipt image format code.
+	Description string `json:",omitempty" validate:"max=35"`
+}
+
 type LabelImageFormat struct {
 	// Label print method code determines the format in which Labels are to
 	// be generated. For EPL2 formatted Labels use EPL, for PNG formatted
@@ -1,6 +1,7 @@
 package ups
 
 import (
+	"bytes"
 	"encoding/json"
 )
 
@@ -80,6 +81,37 @@ type ShipmentResults struct {
 	// Returned Package Information.
 	// Applicable only for ShipmentResponse and ShipAcceptResponse.
 	PackageResults []PackageResults
+	// Container for the High Value reports or the COD turn in page when
+	// forward shipments have declared value or collect on delivery.
+	// Applicable only for ShipmentResponse and ShipAcceptResponse.
+	ControlLogReceipts []ControlLogReceipt
+	// Container that holds the International Forms when requested.
+	// Applicable only for ShipmentResponse and ShipAcceptResponse.
+	Form *Form
+	// The container of the COD Turn In Page. Returned for shipments with
+	// COD.
+	// Applicable only for ShipmentResponse and ShipAcceptResponse.
+	CODTurnInPage *CODTurnInPage
+	// Ground Freight Pricing shipment data. Returned for Ground Freight
+	// Pricing shipments only.
+	FRSShipmentData *FRSShipmentData
+	// Indicates the rating method. 01 = Shipment level, 02 = Package level.
+	RatingMethod string
+	// Unique key returned in the first phase of a Mail Innovations Dual
+	// Return Shipment, which has to be sent in the second phase.
+	MIDualReturnShipmentKey string
+	// URL to retrieve the labels. Returned if LabelLinksIndicator was
+	// requested.
+	LabelURL string
+	// URL to retrieve the labels in the local language. Not returned if
+	// Locale was requested.
+	LocalLanguageLabelURL string
+	// URL to retrieve the receipts. Returned if LabelLinksIndicator was
+	// requested.
+	ReceiptURL string
+	// URL to retrieve the receipts in the local language. Not returned if
+	// Locale was requested.
+	LocalLanguageReceiptURL string
 }
 
 func (s *ShipmentResults) UnmarshalJSON(data []byte) error {
@@ -111,9 +143,144 @@ func (s *ShipmentResults) UnmarshalJSON(data []byte) error {
 		}
 	}
 
+	if receipts, ok := v["ControlLogReceipt"]; ok {
+		err := unmarshalOneOrMany(receipts, &s.ControlLogReceipts)
+		if err != nil {
+			return err
+		}
+	}
+
+	if page, ok := v["CODTurnInPage"]; ok {
+		err := json.Unmarshal(page, &s.CODTurnInPage)
+		if err != nil {
+			return err
+		}
+	}
+
+	if form, ok := v["Form"]; ok {
+		err := json.Unmarshal(form, &s.Form)
+		if err != nil {
+			return err
+		}
+	}
+
+	if data, ok := v["FRSShipmentData"]; ok {
+		err := json.Unmarshal(data, &s.FRSShipmentData)
+		if err != nil {
+			return err
+		}
+	}
+
+	if method, ok := v["RatingMethod"]; ok {
+		err := json.Unmarshal(method, &s.RatingMethod)
+		if err != nil {
+			return err
+		}
+	}
+
+	if key, ok := v["MIDualReturnShipmentKey"]; ok {
+		err := json.Unmarshal(key, &s.MIDualReturnShipmentKey)
+		if err != nil {
+			return err
+		}
+	}
+
+	for name, url := range map[string]*string{
+		"LabelURL":                &s.LabelURL,
+		"LocalLanguageLabelURL":   &s.LocalLanguageLabelURL,
+		"ReceiptURL":              &s.ReceiptURL,
+		"LocalLanguageReceiptURL": &s.LocalLanguageReceiptURL,
+	} {
+		if value, ok := v[name]; ok {
+			err := json.Unmarshal(value, url)
+			if err != nil {
+				return err
+			}
+		}
+	}
+
 	return nil
 }
 
+// unmarshalOneOrMany decodes data into v. UPS returns a single object instead
+// of an array if there is only one element.
+func unmarshalOneOrMany[T any](data json.RawMessage, v *[]T) error {
+	data = bytes.TrimLeft(data, " \t\r\n")
+	if len(data) > 0 && data[0] == '{' {
+		*v = make([]T, 1)
+
+		return json.Unmarshal(data, &(*v)[0])
+	}
+
+	return json.Unmarshal(data, v)
+}
+
+type FRSShipmentData struct {
+	// Ground Freight Pricing transportation charges.
+	TransportationCharges FRSTransportationCharges
+	// Freight density rate container. Returned for Density Based Rating.
+	FreightDensityRate *FreightDensityRate
+	// Handling units container. Returned for Density Based Rating.
+	HandlingUnits []FRSHandlingUnit
+}
+
+func (d *FRSShipmentData) UnmarshalJSON(data []byte) error {
+	type alias FRSShipmentData
+
+	var v struct {
+		alias
+		HandlingUnits json.RawMessage
+	}
+
+	if err := json.Unmarshal(data, &v); err != nil {
+		return err
+	}
+
+	*d = FRSShipmentData(v.alias)
+
+	if len(v.HandlingUnits) > 0 {
+		return unmarshalOneOrMany(v.HandlingUnits, &d.HandlingUnits)
+	}
+
+	return nil
+}
+
+type FRSTransportationCharges struct {
+	// The charge before the discount.
+	GrossCharge Charge
+	// The discount amount.
+	DiscountAmount Charge
+	// The discount in percent.
+	DiscountPercentage string
+	// The charge after the discount.
+	NetCharge Charge
+}
+
+type Charge struct {
+	// The IATA currency code associated with the amount.
+	CurrencyCode string
+	// The monetary value of the charge.
+	MonetaryValue string
+}
+
+type FreightDensityRate struct {
+	// Density of the shipment in pounds per cubic foot.
+	Density string
+	// Total cubic feet of the handling units.
+	TotalCubicFeet string
+}
+
+type FRSHandlingUnit struct {
+	// Handling unit quantity.
+	Quantity string
+	// Handling unit type container.
+	Type HandlingUnitType
+	// Dimensions container of the handling unit.
+	Dimensions HandlingUnitDimensions
+	// Adjusted height container, returned if the height was adjusted.
+	AdjustedHeight *AdjustedHeight
+}
+
 type PackageResults struct {
 	// Package 1Z number. For Mail Innovations shipments, please use
 	// the USPSPICNumber when tracking packages (a non-1Z number
@@ -126,6 +293,10 @@ type PackageResults struct {
 	// shipments with SubVersion greater than or equal to 1707.
 	// Applicable only for ShipmentResponse and ShipAcceptResponse.
 	ShippingLabel *ShippingLabel
+	// The container for UPS shipping receipt. Returned if
+	// ReceiptSpecification was requested.
+	// Applicable only for ShipmentResponse and ShipAcceptResponse.
+	ShippingReceipt *ShippingReceipt
 }
 
 type ShippingLabel struct {
@@ -140,6 +311,60 @@ type ShippingLabel struct {
 	// for Mail Innovations CN22 Combination Forward Label with more
 	// than 3 commodities.
 	GraphicImagePart string
+	// Base 64 encoded html browser image rendering software. This is
+	// only returned for gif image formats.
+	// Applicable only for ShipmentResponse and ShipAcceptResponse.
+	HTMLImage string
+}
+
+type ShippingReceipt struct {
+	// The container image format.
+	ImageFormat ImageFormat
+	// Base 64 encoded receipt image.
+	GraphicImage string
+}
+
+type ControlLogReceipt struct {
+	// Format of the control log receipt image.
+	ImageFormat ImageFormat
+	// Base 64 encoded html, EPL, ZPL or SPL image.
+	GraphicImage string
+}
+
+type CODTurnInPage struct {
+	// The container for the COD Turn In Page image.
+	Image Image
+}
+
+type Image struct {
+	// The container for the image format.
+	ImageFormat ImageFormat
+	// Base 64 encoded graphic image.
+	GraphicImage string
+}
+
+type Form struct {
+	// Code that indicates the type of form.
+	// Valid values: 01 - All Requested International Forms.
+	Code string
+	// Description that indicates the type of form. Possible Values: All
+	// Requested International Forms.
+	Description string
+	// Container that holds the International Forms image.
+	Image *FormImage
+	// Unique Id for later retrieval of saved version of the completed
+	// international forms. Always returned when code = 01.
+	FormGroupID string `json:"FormGroupId"`
+	// Contains description text which identifies the group of international
+	// forms.
+	FormGroupIDName string `json:"FormGroupIdName"`
+}
+
+type FormImage struct {
+	// Format of the international forms image. Valid value: PDF.
+	ImageFormat ImageFormat
+	// Base 64 encoded International forms image.
+	GraphicImage string
 }
 
 type ImageFormat struct {
@@ -160,6 +385,31 @@ type VoidShipmentResponse struct {
 	Response Response
 	// Container for the Summary Result.
 	SummaryResult SummaryResult
+	// Results of the voided packages. Returned if tracking numbers were
+	// given.
+	PackageLevelResults []PackageLevelResult
+}
+
+func (r *VoidShipmentResponse) UnmarshalJSON(data []byte) error {
+	type alias VoidShipmentResponse
+
+	var v struct {
+		alias
+		PackageLevelResult json.RawMessage
+	}
+
+	err := json.Unmarshal(data, &v)
+	if err != nil {
+		return err
+	}
+
+	*r = VoidShipmentResponse(v.alias)
+
+	if len(v.PackageLevelResult) > 0 {
+		return unmarshalOneOrMany(v.PackageLevelResult, &r.PackageLevelResults)
+	}
+
+	return nil
 }
 
 type SummaryResult struct {
@@ -0,0 +1,143 @@
+package ups
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+)
+
+// Simple Rate size codes of SimpleRate.Code.
+const (
+	SimpleRateExtraSmall = "XS"
+	SimpleRateSmall      = "S"
+	SimpleRateMedium     = "M"
+	SimpleRateLarge      = "L"
+	SimpleRateExtraLarge = "XL"
+)
+
+// ErrSimpleRateTooLarge is returned if a package is larger than the Simple
+// Rate XL size.
+var ErrSimpleRateTooLarge = errors.New("package is too large for Simple Rate")
+
+type simpleRateBand struct {
+	code        string
+	description string
+	cubicInches float64
+}
+
+// simpleRateBands are the upper limits of the Simple Rate sizes in cubic
+// inches.
+var simpleRateBands = []simpleRateBand{
+	{SimpleRateExtraSmall, "Extra Small", 100},
+	{SimpleRateSmall, "Small", 250},
+	{SimpleRateMedium, "Medium", 650},
+	{SimpleRateLarge, "Large", 1050},
+	{SimpleRateExtraLarge, "Extra Large", 1728},
+}
+
+// simpleRateServices contains the services Simple Rate can be combined with:
+// Next Day Air, 2nd Day Air, Ground, 3 Day Select and Next Day Air Saver.
+var simpleRateServices = []ServiceCode{ServiceNextDayAir, Service2ndDayAir, ServiceGround, Service3DaySelect, ServiceNextDayAirSaver}
+
+// simpleRateMaxWeightLBS is the maximum weight of a Simple Rate package.
+const simpleRateMaxWeightLBS = 50
+
+// CubicInches returns the volume of the package dimensions in cubic inches.
+func (d *Dimensions) CubicInches() (float64, error) {
+	length, width, height, err := d.ValuesIn(DimensionUnitInches)
+	if err != nil {
+		return 0, err
+	}
+
+	return length * width * height, nil
+}
+
+// SimpleRateForDimensions returns the Simple Rate size matching the cubic
+// inch bands of UPS. ErrSimpleRateTooLarge is returned for packages larger
+// than 1,728 cubic inches.
+func SimpleRateForDimensions(dimensions Dimensions) (*SimpleRate, error) {
+	volume, err := dimensions.CubicInches()
+	if err != nil {
+		return nil, err
+	}
+
+	for _, band := range simpleRateBands {
+		if volume <= band.cubicInches {
+			return &SimpleRate{
+				Code:        band.code,
+				Description: band.description,
+			}, nil
+		}
+	}
+
+	return nil, ErrSimpleRateTooLarge
+}
+
+// SetSimpleRate sets SimpleRate to the size matching the dimensions of the
+// package.
+func (p *Package) SetSimpleRate() error {
+	simpleRate, err := SimpleRateForDimensions(p.Dimensions)
+	if err != nil {
+		return err
+	}
+
+	p.SimpleRate = simpleRate
+
+	return nil
+}
+
+func (s *Shipment) validateSimpleRate() []error {
+	var errs []error
+
+	for i, p := range s.Packages {
+		if p.SimpleRate == nil {
+			continue
+		}
+
+		field := fmt.Sprintf("Shipment.Package[%d].SimpleRate", i)
+
+		if !slices.ContainsFunc(simpleRateBands, func(band simpleRateBand) bool {
+			return band.code == p.SimpleRate.Code
+		}) {
+			errs = append(errs, newValidationError(field+".Code", "%q is not a valid Simple Rate size", p.SimpleRate.Code))
+		}
+
+		if !slices.Contains(simpleRateServices, ServiceCode(s.Service.Code)) {
+			errs = append(errs, newValidationError(field, "not available for service %s", s.Service.Code))
+		}
+
+		if PackagingCode(p.Packaging.Code) != PackagingCustomerSupplied {
+			errs = append(errs, newValidationError(field, "only available for customer supplied packaging 02, got %s", p.Packaging.Code))
+		}
+
+		if s.originCountryCode() != "US" || s.destinationCountryCode() != "US" {
+			errs = append(errs, newValidationError(field, "only available for shipments within the US"))
+		}
+
+		if d := p.Dimensions; d.Length != "" || d.Width != "" || d.Height != "" {
+			expected, err := SimpleRateForDimensions(d)
+
+			switch {
+			case errors.Is(err, ErrSimpleRateTooLarge):
+				errs = append(errs, newValidationError(field, "%s", err))
+			case err != nil:
+				errs = append(errs, newValidationError(fmt.Sprintf("Shipment.Package[%d].Dimensions", i), "%s", err))
+			case expected.Code != p.SimpleRate.Code:
+				errs = append(errs, newValidationError(field+".Code", "dimensions require size %s, got %s", expected.Code, p.SimpleRate.Code))
+			}
+		}
+
+		if w := p.PackageWeight; w != nil {
+			weight, err := w.ValueIn(WeightUnitPounds)
+
+			switch {
+			case err != nil:
+				errs = append(errs, newValidationError(fmt.Sprintf("Shipment.Package[%d].PackageWeight", i), "%s", err))
+			case weight > simpleRateMaxWeightLBS:
+				errs = append(errs, newValidationError(field, "packages over %d lbs are not eligible, got %s %s", simpleRateMaxWeightLBS, w.Weight, w.UnitOfMeasurement.Code))
+			}
+		}
+	}
+
+	return errs
+}
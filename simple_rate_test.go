@@ -0,0 +1,156 @@
+package ups
+
+import (
+	"errors"
+	"testing"
+)
+
+func TestSimpleRateForDimensions(t *testing.T) {
+	tests := []struct {
+		name       string
+		dimensions Dimensions
+		want       string
+		wantErr    error
+	}{
+		{"extra small", Dimensions{UnitOfMeasurement: DimensionsUnitOfMeasurement{Code: "IN"}, Length: "5", Width: "5", Height: "4"}, SimpleRateExtraSmall, nil},
+		{"small", Dimensions{UnitOfMeasurement: DimensionsUnitOfMeasurement{Code: "IN"}, Length: "10", Width: "5", Height: "5"}, SimpleRateSmall, nil},
+		{"medium", Dimensions{UnitOfMeasurement: DimensionsUnitOfMeasurement{Code: "IN"}, Length: "13", Width: "10", Height: "5"}, SimpleRateMedium, nil},
+		{"large", Dimensions{UnitOfMeasurement: DimensionsUnitOfMeasurement{Code: "IN"}, Length: "15", Width: "10", Height: "7"}, SimpleRateLarge, nil},
+		{"extra large", Dimensions{UnitOfMeasurement: DimensionsUnitOfMeasurement{Code: "IN"}, Length: "12", Width: "12", Height: "12"}, SimpleRateExtraLarge, nil},
+		{"centimeters", Dimensions{UnitOfMeasurement: DimensionsUnitOfMeasurement{Code: "CM"}, Length: "25.4", Width: "12.7", Height: "12.7"}, SimpleRateSmall, nil},
+		{"too large", Dimensions{UnitOfMeasurement: DimensionsUnitOfMeasurement{Code: "IN"}, Length: "13", Width: "12", Height: "12"}, "", ErrSimpleRateTooLarge},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := SimpleRateForDimensions(tt.dimensions)
+			if !errors.Is(err, tt.wantErr) {
+				t.Fatalf("SimpleRateForDimensions() error = %v, want %v", err, tt.wantErr)
+			}
+
+			if err == nil && got.Code != tt.want {
+				t.Errorf("SimpleRateForDimensions() = %s, want %s", got.Code, tt.want)
+			}
+		})
+	}
+}
+
+func TestValidateSimpleRate(t *testing.T) {
+	dimensions := Dimensions{UnitOfMeasurement: DimensionsUnitOfMeasurement{Code: "IN"}, Length: "10", Width: "5", Height: "5"}
+
+	tests := []struct {
+		name        string
+		destination string
+		service     string
+		packaging   string
+		simpleRate  string
+		dimensions  Dimensions
+		weight      *PackageWeight
+		want        []string
+	}{
+		{
+			name:       "valid",
+			simpleRate: SimpleRateSmall,
+			dimensions: dimensions,
+			weight:     &PackageWeight{UnitOfMeasurement: PackageWeightUnitOfMeasurement{Code: "LBS"}, Weight: "50"},
+		},
+		{
+			name:       "without dimensions",
+			simpleRate: SimpleRateMedium,
+		},
+		{
+			name:       "pounds over limit",
+			simpleRate: SimpleRateSmall,
+			weight:     &PackageWeight{UnitOfMeasurement: PackageWeightUnitOfMeasurement{Code: "LBS"}, Weight: "50.1"},
+			want:       []string{"Shipment.Package[0].SimpleRate"},
+		},
+		{
+			name:       "kilograms over limit",
+			simpleRate: SimpleRateSmall,
+			weight:     &PackageWeight{UnitOfMeasurement: PackageWeightUnitOfMeasurement{Code: "KGS"}, Weight: "23"},
+			want:       []string{"Shipment.Package[0].SimpleRate"},
+		},
+		{
+			name:       "kilograms within limit",
+			simpleRate: SimpleRateSmall,
+			weight:     &PackageWeight{UnitOfMeasurement: PackageWeightUnitOfMeasurement{Code: "KGS"}, Weight: "22.6"},
+		},
+		{
+			name:       "ounces over limit",
+			simpleRate: SimpleRateSmall,
+			weight:     &PackageWeight{UnitOfMeasurement: PackageWeightUnitOfMeasurement{Code: "OZS"}, Weight: "801"},
+			want:       []string{"Shipment.Package[0].SimpleRate"},
+		},
+		{
+			name:       "invalid weight",
+			simpleRate: SimpleRateSmall,
+			weight:     &PackageWeight{UnitOfMeasurement: PackageWeightUnitOfMeasurement{Code: "LBS"}, Weight: "fifty"},
+			want:       []string{"Shipment.Package[0].PackageWeight"},
+		},
+		{
+			name:       "unknown weight unit",
+			simpleRate: SimpleRateSmall,
+			weight:     &PackageWeight{UnitOfMeasurement: PackageWeightUnitOfMeasurement{Code: "TON"}, Weight: "1"},
+			want:       []string{"Shipment.Package[0].PackageWeight"},
+		},
+		{
+			name:       "invalid dimensions",
+			simpleRate: SimpleRateSmall,
+			dimensions: Dimensions{UnitOfMeasurement: DimensionsUnitOfMeasurement{Code: "IN"}, Length: "10", Width: "5"},
+			want:       []string{"Shipment.Package[0].Dimensions"},
+		},
+		{
+			name:       "size does not match dimensions",
+			simpleRate: SimpleRateLarge,
+			dimensions: dimensions,
+			want:       []string{"Shipment.Package[0].SimpleRate.Code"},
+		},
+		{
+			name:       "too large",
+			simpleRate: SimpleRateExtraLarge,
+			dimensions: Dimensions{UnitOfMeasurement: DimensionsUnitOfMeasurement{Code: "IN"}, Length: "20", Width: "20", Height: "20"},
+			want:       []string{"Shipment.Package[0].SimpleRate"},
+		},
+		{
+			name:        "invalid size, service, packaging and route",
+			destination: "CA",
+			service:     "11",
+			packaging:   "01",
+			simpleRate:  "XXL",
+			want: []string{
+				"Shipment.Package[0].SimpleRate.Code",
+				"Shipment.Package[0].SimpleRate",
+				"Shipment.Package[0].SimpleRate",
+				"Shipment.Package[0].SimpleRate",
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			destination, service, packaging := "US", "03", "02"
+			if tt.destination != "" {
+				destination = tt.destination
+			}
+
+			if tt.service != "" {
+				service = tt.service
+			}
+
+			if tt.packaging != "" {
+				packaging = tt.packaging
+			}
+
+			s := testShipment("US", destination)
+			s.Service.Code = service
+			s.Packages = []Package{{
+				Packaging:     Packaging{Code: packaging},
+				Dimensions:    tt.dimensions,
+				PackageWeight: tt.weight,
+				SimpleRate:    &SimpleRate{Code: tt.simpleRate},
+			}}
+
+			checkValidationFields(t, errors.Join(s.validateSimpleRate()...), tt.want)
+		})
+	}
+}
@@ -0,0 +1,111 @@
+package ups
+
+import "strings"
+
+// WeightUnit is the unit of PackageWeightUnitOfMeasurement.Code and
+// DimWeightUnitOfMeasurement.Code.
+type WeightUnit string
+
+// Weight units. The Code of PackageWeightUnitOfMeasurement and
+// DimWeightUnitOfMeasurement is a string, so the constants are assigned with
+// string(WeightUnitKilograms).
+const (
+	WeightUnitPounds    WeightUnit = "LBS"
+	WeightUnitKilograms WeightUnit = "KGS"
+	WeightUnitOunces    WeightUnit = "OZS"
+)
+
+// DimensionUnit is the unit of DimensionsUnitOfMeasurement.Code.
+type DimensionUnit string
+
+// Dimension units. DimensionsUnitOfMeasurement.Code is a string, so the
+// constants are assigned with string(DimensionUnitCentimeters).
+const (
+	DimensionUnitInches      DimensionUnit = "IN"
+	DimensionUnitCentimeters DimensionUnit = "CM"
+	// DimensionUnitMetric is the metric system, i.e. centimeters.
+	DimensionUnitMetric DimensionUnit = "00"
+	// DimensionUnitEnglish is the English system, i.e. inches.
+	DimensionUnitEnglish DimensionUnit = "01"
+)
+
+// UnitInfo describes a unit of measurement.
+type UnitInfo struct {
+	Code string
+	// Name to display.
+	Name string
+	// Metric is true for metric units.
+	Metric bool
+}
+
+func (u UnitInfo) code() string    { return u.Code }
+func (u UnitInfo) name() string    { return u.Name }
+func (u UnitInfo) clone() UnitInfo { return u }
+
+var (
+	weightUnits = registry[UnitInfo]{
+		{string(WeightUnitPounds), "Pounds", false},
+		{string(WeightUnitKilograms), "Kilograms", true},
+		{string(WeightUnitOunces), "Ounces", false},
+	}
+	dimensionUnits = registry[UnitInfo]{
+		{string(DimensionUnitInches), "Inches", false},
+		{string(DimensionUnitCentimeters), "Centimeters", true},
+		{string(DimensionUnitMetric), "Metric Units Of Measurement", true},
+		{string(DimensionUnitEnglish), "English Units Of Measurement", false},
+	}
+)
+
+// WeightUnits returns all known weight units.
+func WeightUnits() []UnitInfo {
+	return weightUnits.all()
+}
+
+// Info returns the description of the unit. ok is false for unknown units.
+// Lower case codes are accepted.
+func (u WeightUnit) Info() (info UnitInfo, ok bool) {
+	return weightUnits.info(strings.ToUpper(string(u)))
+}
+
+// IsValid reports whether the unit is known.
+func (u WeightUnit) IsValid() bool {
+	return weightUnits.isValid(strings.ToUpper(string(u)))
+}
+
+// Name returns the display name of the unit or the code if it is unknown.
+func (u WeightUnit) Name() string {
+	return weightUnits.name(strings.ToUpper(string(u)))
+}
+
+// IsMetric reports whether the unit is a metric unit.
+func (u WeightUnit) IsMetric() bool {
+	info, ok := u.Info()
+	return ok && info.Metric
+}
+
+// DimensionUnits returns all known dimension units.
+func DimensionUnits() []UnitInfo {
+	return dimensionUnits.all()
+}
+
+// Info returns the description of the unit. ok is false for unknown units.
+// Lower case codes are accepted.
+func (u DimensionUnit) Info() (info UnitInfo, ok bool) {
+	return dimensionUnits.info(strings.ToUpper(string(u)))
+}
+
+// IsValid reports whether the unit is known.
+func (u DimensionUnit) IsValid() bool {
+	return dimensionUnits.isValid(strings.ToUpper(string(u)))
+}
+
+// Name returns the display name of the unit or the code if it is unknown.
+func (u DimensionUnit) Name() string {
+	return dimensionUnits.name(strings.ToUpper(string(u)))
+}
+
+// IsMetric reports whether the unit is a metric unit.
+func (u DimensionUnit) IsMetric() bool {
+	info, ok := u.Info()
+	return ok && info.Metric
+}
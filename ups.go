@@ -1,7 +1,9 @@
 package ups
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"io"
 	"net/http"
@@ -15,8 +17,11 @@ const (
 	Testing    Environment = "https://wwwcie.ups.com"
 	Production Environment = "https://onlinetools.ups.com"
 
-	shipmentURL = "/api/shipments/v2403/ship"
-	oauthURL    = "/security/v1/oauth"
+	shipmentURL       = "/api/shipments/v2403/ship"
+	oauthURL          = "/security/v1/oauth"
+	dangerousGoodsURL = "/api/dangerousgoods/v1"
+	freightURL        = "/api/freight/v1"
+	labelRecoveryURL  = "/api/labels/v1/recovery"
 )
 
 type Client struct {
@@ -146,3 +151,67 @@ func (c *Client) logHTTPResponse(res *http.Response) error {
 	_, err = fmt.Fprint(c.logWriter, string(b))
 	return err
 }
+
+// do sends request as JSON to the API and decodes the answer into response.
+// An ErrorResponse is returned if UPS answers with errors.
+func (c *Client) do(ctx context.Context, method, url string, request, response any) error {
+	var body io.Reader
+	if request != nil {
+		jsonBody, err := json.MarshalIndent(request, "", "  ")
+		if err != nil {
+			return err
+		}
+
+		body = bytes.NewReader(jsonBody)
+	}
+
+	req, err := http.NewRequestWithContext(ctx, method, fmt.Sprintf("%s%s", c.environment, url), body)
+	if err != nil {
+		return err
+	}
+
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("Accept", "application/json")
+
+	err = c.addAuthorization(ctx, req)
+	if err != nil {
+		return err
+	}
+
+	err = c.logHTTPRequest(req)
+	if err != nil {
+		return err
+	}
+
+	res, err := c.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer res.Body.Close()
+
+	err = c.logHTTPResponse(res)
+	if err != nil {
+		return err
+	}
+
+	data, err := io.ReadAll(res.Body)
+	if err != nil {
+		return err
+	}
+
+	var errorResponse struct {
+		ErrorResponse *ErrorResponse `json:"response"`
+	}
+
+	err = json.Unmarshal(data, &errorResponse)
+	if err != nil {
+		return err
+	}
+
+	if errorResponse.ErrorResponse != nil {
+		errorResponse.ErrorResponse.StatusCode = res.StatusCode
+		return errorResponse.ErrorResponse
+	}
+
+	return json.Unmarshal(data, response)
+}
@@ -0,0 +1,113 @@
+package ups
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+)
+
+// ValidationError describes a constraint of a request which is violated.
+type ValidationError struct {
+	// Field is the path of the invalid field, e.g.
+	// Shipment.ShipmentServiceOptions.COD.
+	Field   string
+	Message string
+}
+
+func (e *ValidationError) Error() string {
+	return e.Field + ": " + e.Message
+}
+
+func newValidationError(field, format string, args ...any) *ValidationError {
+	return &ValidationError{
+		Field:   field,
+		Message: fmt.Sprintf(format, args...),
+	}
+}
+
+// Validate checks constraints between the fields of the request which UPS
+// would reject. It does not replace the validation done by UPS, but reports
+// common mistakes before a label is requested. All violations are joined
+// into the returned error and can be inspected using errors.As with
+// *ValidationError.
+func (r *ShipmentRequest) Validate() error {
+	var errs []error
+
+	if spec := r.ReceiptSpecification; spec != nil {
+		switch ParseLabelFormat(spec.ImageFormat.Code) {
+		case LabelFormatEPL, LabelFormatSPL, LabelFormatZPL, LabelFormatStarPL, LabelFormatHTML:
+		default:
+			errs = append(errs, newValidationError("ReceiptSpecification.ImageFormat.Code", "%q is not valid, use EPL, SPL, ZPL, STARPL or HTML", spec.ImageFormat.Code))
+		}
+	}
+
+	errs = append(errs, r.Shipment.validatePayment()...)
+	errs = append(errs, r.Shipment.validateCOD()...)
+	errs = append(errs, r.Shipment.validatePackageServiceOptions()...)
+	errs = append(errs, r.Shipment.validateHazMat()...)
+	errs = append(errs, r.Shipment.validateCustoms()...)
+	errs = append(errs, r.Shipment.validateFreight()...)
+	errs = append(errs, r.Shipment.validateSimpleRate()...)
+	errs = append(errs, r.Shipment.validateReturnService()...)
+	errs = append(errs, r.Shipment.validateDualReturn()...)
+	errs = append(errs, r.Shipment.validateAccessPoint()...)
+	errs = append(errs, r.Shipment.validateNotifications()...)
+	errs = append(errs, r.Shipment.validateLabelDelivery()...)
+
+	return errors.Join(errs...)
+}
+
+// euCountries contains the member states of the European Union.
+var euCountries = []string{
+	"AT", "BE", "BG", "CY", "CZ", "DE", "DK", "EE", "ES", "FI", "FR", "GR", "HR", "HU",
+	"IE", "IT", "LT", "LU", "LV", "MT", "NL", "PL", "PT", "RO", "SE", "SI", "SK",
+}
+
+func isEUCountry(countryCode string) bool {
+	return slices.Contains(euCountries, strings.ToUpper(countryCode))
+}
+
+// originCountryCode returns the country the shipment is rated from, which is
+// ShipFrom if present and Shipper otherwise.
+func (s *Shipment) originCountryCode() string {
+	if s.ShipFrom != nil && s.ShipFrom.Address.CountryCode != "" {
+		return strings.ToUpper(s.ShipFrom.Address.CountryCode)
+	}
+
+	return strings.ToUpper(s.Shipper.Address.CountryCode)
+}
+
+func (s *Shipment) destinationCountryCode() string {
+	return strings.ToUpper(s.ShipTo.Address.CountryCode)
+}
+
+// validateMonetaryValue checks that value is a positive amount with up to two
+// decimal places.
+func validateMonetaryValue(field, value string) error {
+	return validateDecimal(field, value, 2)
+}
+
+// validateDecimal checks that value is a positive number with up to the
+// given number of decimal places.
+func validateDecimal(field, value string, decimals int) error {
+	number, err := strconv.ParseFloat(value, 64)
+	if err != nil || number <= 0 {
+		return newValidationError(field, "%q is not a positive number", value)
+	}
+
+	if i := strings.IndexByte(value, '.'); i >= 0 && len(value)-i-1 > decimals {
+		return newValidationError(field, "%q has more than %d decimal places", value, decimals)
+	}
+
+	return nil
+}
+
+func validateCurrencyCode(field, currencyCode string) error {
+	if len(currencyCode) != 3 {
+		return newValidationError(field, "%q is not a 3 letter currency code", currencyCode)
+	}
+
+	return nil
+}
@@ -0,0 +1,96 @@
+package ups
+
+import "strings"
+
+// voidedStatusCode is the status code of a voided shipment or package.
+const voidedStatusCode = "1"
+
+// VoidResult is the outcome of voiding a shipment.
+type VoidResult int
+
+const (
+	// VoidResultFailed means that nothing was voided.
+	VoidResultFailed VoidResult = iota
+	// VoidResultPartial means that only some of the requested packages were
+	// voided.
+	VoidResultPartial
+	// VoidResultFull means that the shipment or all requested packages were
+	// voided.
+	VoidResultFull
+)
+
+func (r VoidResult) String() string {
+	switch r {
+	case VoidResultPartial:
+		return "partial"
+	case VoidResultFull:
+		return "full"
+	}
+
+	return "failed"
+}
+
+// formatTrackingNumbers formats the tracking numbers for the trackingnumber
+// query parameter. Multiple numbers are enclosed in square brackets.
+func formatTrackingNumbers(trackingNumbers []string) string {
+	if len(trackingNumbers) == 1 {
+		return trackingNumbers[0]
+	}
+
+	return "[" + strings.Join(trackingNumbers, ",") + "]"
+}
+
+// IsVoided reports whether the package was voided.
+func (r *PackageLevelResult) IsVoided() bool {
+	return r.Status.Code == voidedStatusCode
+}
+
+// Result returns whether the shipment or the requested packages were voided
+// completely or partially.
+func (r *VoidShipmentResponse) Result() VoidResult {
+	if len(r.PackageLevelResults) == 0 {
+		if r.SummaryResult.Status.Code == voidedStatusCode {
+			return VoidResultFull
+		}
+
+		return VoidResultFailed
+	}
+
+	voided := len(r.VoidedTrackingNumbers())
+
+	switch voided {
+	case 0:
+		return VoidResultFailed
+	case len(r.PackageLevelResults):
+		return VoidResultFull
+	}
+
+	return VoidResultPartial
+}
+
+// VoidedTrackingNumbers returns the tracking numbers of the voided packages.
+func (r *VoidShipmentResponse) VoidedTrackingNumbers() []string {
+	var trackingNumbers []string
+
+	for i := range r.PackageLevelResults {
+		if r.PackageLevelResults[i].IsVoided() {
+			trackingNumbers = append(trackingNumbers, r.PackageLevelResults[i].TrackingNumber)
+		}
+	}
+
+	return trackingNumbers
+}
+
+// NotVoidedTrackingNumbers returns the tracking numbers of the packages
+// which could not be voided.
+func (r *VoidShipmentResponse) NotVoidedTrackingNumbers() []string {
+	var trackingNumbers []string
+
+	for i := range r.PackageLevelResults {
+		if !r.PackageLevelResults[i].IsVoided() {
+			trackingNumbers = append(trackingNumbers, r.PackageLevelResults[i].TrackingNumber)
+		}
+	}
+
+	return trackingNumbers
+}
@@ -0,0 +1,101 @@
+package ups
+
+import (
+	"context"
+	"net/http"
+	"slices"
+	"testing"
+)
+
+func TestVoidShipment(t *testing.T) {
+	voided := `{"Status":{"Code":"1"},"TrackingNumber":"1Z999AA10123456784"}`
+	notVoided := `{"Status":{"Code":"0"},"TrackingNumber":"1Z999AA10123456795"}`
+
+	tests := []struct {
+		name            string
+		trackingNumbers []string
+		packageResults  string
+		summaryStatus   string
+		wantQuery       string
+		wantResult      VoidResult
+		wantVoided      []string
+		wantNotVoided   []string
+	}{
+		{
+			name:          "shipment",
+			summaryStatus: "1",
+			wantResult:    VoidResultFull,
+		},
+		{
+			name:          "shipment failed",
+			summaryStatus: "0",
+			wantResult:    VoidResultFailed,
+		},
+		{
+			name:            "single package",
+			trackingNumbers: []string{"1Z999AA10123456784"},
+			packageResults:  voided,
+			summaryStatus:   "1",
+			wantQuery:       "1Z999AA10123456784",
+			wantResult:      VoidResultFull,
+			wantVoided:      []string{"1Z999AA10123456784"},
+		},
+		{
+			name:            "partial",
+			trackingNumbers: []string{"1Z999AA10123456784", "1Z999AA10123456795"},
+			packageResults:  "[" + voided + "," + notVoided + "]",
+			summaryStatus:   "1",
+			wantQuery:       "[1Z999AA10123456784,1Z999AA10123456795]",
+			wantResult:      VoidResultPartial,
+			wantVoided:      []string{"1Z999AA10123456784"},
+			wantNotVoided:   []string{"1Z999AA10123456795"},
+		},
+		{
+			name:            "no package voided",
+			trackingNumbers: []string{"1Z999AA10123456795"},
+			packageResults:  "[" + notVoided + "]",
+			summaryStatus:   "0",
+			wantQuery:       "1Z999AA10123456795",
+			wantResult:      VoidResultFailed,
+			wantNotVoided:   []string{"1Z999AA10123456795"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			c := newTestClient(t, func(w http.ResponseWriter, r *http.Request) {
+				if r.Method != http.MethodDelete || r.URL.Path != shipmentURL+"/cancel/1ZISDE016691676846" {
+					t.Errorf("request %s %s", r.Method, r.URL.Path)
+				}
+
+				if got := r.URL.Query().Get("trackingnumber"); got != tt.wantQuery {
+					t.Errorf("trackingnumber = %q, want %q", got, tt.wantQuery)
+				}
+
+				response := `{"VoidShipmentResponse":{"Response":{"ResponseStatus":{"Code":"1"}},"SummaryResult":{"Status":{"Code":"` + tt.summaryStatus + `"}}`
+				if tt.packageResults != "" {
+					response += `,"PackageLevelResult":` + tt.packageResults
+				}
+
+				w.Write([]byte(response + `}}`))
+			})
+
+			response, err := c.VoidShipment(context.Background(), "1ZISDE016691676846", tt.trackingNumbers...)
+			if err != nil {
+				t.Fatalf("VoidShipment() error = %v", err)
+			}
+
+			if got := response.Result(); got != tt.wantResult {
+				t.Errorf("Result() = %s, want %s", got, tt.wantResult)
+			}
+
+			if got := response.VoidedTrackingNumbers(); !slices.Equal(got, tt.wantVoided) {
+				t.Errorf("VoidedTrackingNumbers() = %v, want %v", got, tt.wantVoided)
+			}
+
+			if got := response.NotVoidedTrackingNumbers(); !slices.Equal(got, tt.wantNotVoided) {
+				t.Errorf("NotVoidedTrackingNumbers() = %v, want %v", got, tt.wantNotVoided)
+			}
+		})
+	}
+}
@@ -0,0 +1,140 @@
+package zpl
+
+import (
+	"fmt"
+	"image"
+	"strings"
+)
+
+// Orientation is the rotation of a field.
+type Orientation byte
+
+const (
+	// OrientationNormal prints the field unrotated.
+	OrientationNormal Orientation = 'N'
+	// OrientationRotated rotates the field 90 degrees clockwise.
+	OrientationRotated Orientation = 'R'
+	// OrientationInverted rotates the field 180 degrees.
+	OrientationInverted Orientation = 'I'
+	// OrientationBottomUp rotates the field 270 degrees clockwise.
+	OrientationBottomUp Orientation = 'B'
+)
+
+func parseOrientation(s string, fallback Orientation) Orientation {
+	s = strings.TrimSpace(s)
+	if s == "" {
+		return fallback
+	}
+
+	switch o := Orientation(s[0]); o {
+	case OrientationNormal, OrientationRotated, OrientationInverted, OrientationBottomUp:
+		return o
+	}
+
+	return fallback
+}
+
+func (o Orientation) orDefault() Orientation {
+	if o == 0 {
+		return OrientationNormal
+	}
+
+	return o
+}
+
+// Field is a field which can be inserted into a label.
+type Field interface {
+	// Commands returns the ZPL commands of the field.
+	Commands() []Command
+	// Bounds returns the estimated area covered by the field in dots.
+	Bounds() image.Rectangle
+}
+
+// Text is a text field using the scalable font 0.
+type Text struct {
+	// Position of the upper left corner in dots.
+	X, Y int
+	Text string
+	// Character height and width in dots. Width defaults to Height.
+	Height, Width int
+	Orientation   Orientation
+}
+
+func (t Text) Commands() []Command {
+	width := t.Width
+	if width == 0 {
+		width = t.Height
+	}
+
+	return []Command{
+		{Prefix: '^', Name: "FO", Parameters: fmt.Sprintf("%d,%d", t.X, t.Y)},
+		{Prefix: '^', Name: "A0", Parameters: fmt.Sprintf("%c,%d,%d", t.Orientation.orDefault(), t.Height, width)},
+		{Prefix: '^', Name: "FH"},
+		{Prefix: '^', Name: "FD", Parameters: escape(t.Text)},
+		{Prefix: '^', Name: "FS"},
+	}
+}
+
+func (t Text) Bounds() image.Rectangle {
+	width := t.Width
+	if width == 0 {
+		width = t.Height
+	}
+
+	w, h := width*len([]rune(t.Text)), t.Height
+	if o := t.Orientation.orDefault(); o == OrientationRotated || o == OrientationBottomUp {
+		w, h = h, w
+	}
+
+	return image.Rect(t.X, t.Y, t.X+w, t.Y+h)
+}
+
+// Code128 is a Code 128 barcode field.
+type Code128 struct {
+	// Position of the upper left corner in dots.
+	X, Y int
+	Data string
+	// Bar height in dots.
+	Height int
+	// Width of the narrowest bar in dots. Defaults to 2.
+	ModuleWidth int
+	Orientation Orientation
+	// HideInterpretation suppresses the human readable line below the
+	// barcode.
+	HideInterpretation bool
+}
+
+func (b Code128) moduleWidth() int {
+	if b.ModuleWidth == 0 {
+		return 2
+	}
+
+	return b.ModuleWidth
+}
+
+func (b Code128) Commands() []Command {
+	interpretation := 'Y'
+	if b.HideInterpretation {
+		interpretation = 'N'
+	}
+
+	return []Command{
+		{Prefix: '^', Name: "FO", Parameters: fmt.Sprintf("%d,%d", b.X, b.Y)},
+		{Prefix: '^', Name: "BY", Parameters: fmt.Sprintf("%d", b.moduleWidth())},
+		{Prefix: '^', Name: "BC", Parameters: fmt.Sprintf("%c,%d,%c,N,N", b.Orientation.orDefault(), b.Height, interpretation)},
+		{Prefix: '^', Name: "FH"},
+		{Prefix: '^', Name: "FD", Parameters: escape(b.Data)},
+		{Prefix: '^', Name: "FS"},
+	}
+}
+
+func (b Code128) Bounds() image.Rectangle {
+	parameters := b.Commands()[2]
+	return barcodeBounds(parameters, b.Data, b.X, b.Y, b.moduleWidth(), b.Height, b.Orientation.orDefault())
+}
+
+// escape hex encodes the characters which would end the field data. The
+// field has to be preceded by ^FH.
+func escape(s string) string {
+	return strings.NewReplacer("_", "_5F", "^", "_5E", "~", "_7E").Replace(s)
+}
@@ -0,0 +1,429 @@
+package zpl
+
+import (
+	"bytes"
+	"encoding/base64"
+	"fmt"
+	"image"
+	"image/color"
+	_ "image/gif"
+	_ "image/png"
+	"strconv"
+	"strings"
+
+	"github.com/enthus-golang/ups"
+)
+
+// Dithering is the method used to convert grayscale images to black and
+// white.
+type Dithering int
+
+const (
+	// DitherThreshold prints every pixel darker than the threshold black.
+	DitherThreshold Dithering = iota
+	// DitherFloydSteinberg diffuses the error to the neighbouring pixels.
+	DitherFloydSteinberg
+	// DitherOrdered uses a 4x4 Bayer matrix.
+	DitherOrdered
+)
+
+// Converter rasterizes GIF and PNG labels into ZPL graphic fields.
+type Converter struct {
+	dpi       int
+	stockSize ups.LabelStockSize
+	dithering Dithering
+	threshold uint8
+	rotation  Orientation
+}
+
+type OptionFunction func(*Converter)
+
+func NewConverter(options ...OptionFunction) *Converter {
+	c := &Converter{
+		dpi: 203,
+		stockSize: ups.LabelStockSize{
+			Height: "6",
+			Width:  "4",
+		},
+		threshold: 128,
+	}
+
+	for _, option := range options {
+		option(c)
+	}
+
+	return c
+}
+
+// WithDPI defines the resolution of the printer. Default is 203 dpi.
+func WithDPI(dpi int) OptionFunction {
+	return func(c *Converter) {
+		c.dpi = dpi
+	}
+}
+
+// WithStockSize defines the label size in inches. Default is 4x6.
+func WithStockSize(stockSize ups.LabelStockSize) OptionFunction {
+	return func(c *Converter) {
+		c.stockSize = stockSize
+	}
+}
+
+// WithDithering defines the method used to convert the image to black and
+// white. Default is DitherThreshold.
+func WithDithering(dithering Dithering) OptionFunction {
+	return func(c *Converter) {
+		c.dithering = dithering
+	}
+}
+
+// WithThreshold defines the gray level below which pixels are printed black
+// by DitherThreshold. Default is 128.
+func WithThreshold(threshold uint8) OptionFunction {
+	return func(c *Converter) {
+		c.threshold = threshold
+	}
+}
+
+// WithRotation rotates every image. By default images are rotated clockwise
+// if their orientation does not match the label stock, which turns the
+// landscape GIF labels of UPS upright.
+func WithRotation(rotation Orientation) OptionFunction {
+	return func(c *Converter) {
+		c.rotation = rotation
+	}
+}
+
+// ConvertShippingLabel returns a ZPL label for a GIF or PNG label. Labels
+// which are ZPL already are returned unchanged.
+func (c *Converter) ConvertShippingLabel(label *ups.ShippingLabel) (*ups.ShippingLabel, error) {
+	format := label.Format()
+	if format == ups.LabelFormatZPL {
+		return label, nil
+	}
+
+	if !format.IsRaster() {
+		return nil, fmt.Errorf("label format %s can't be converted", format)
+	}
+
+	parts, err := label.DecodeParts()
+	if err != nil {
+		return nil, err
+	}
+
+	var buf bytes.Buffer
+	for _, part := range parts {
+		img, _, err := image.Decode(bytes.NewReader(part))
+		if err != nil {
+			return nil, err
+		}
+
+		zpl, err := c.Convert(img)
+		if err != nil {
+			return nil, err
+		}
+
+		buf.Write(zpl)
+	}
+
+	return &ups.ShippingLabel{
+		ImageFormat: ups.ImageFormat{
+			Code:        string(ups.LabelFormatZPL),
+			Description: "ZPL",
+		},
+		GraphicImage: base64.StdEncoding.EncodeToString(buf.Bytes()),
+	}, nil
+}
+
+// Convert returns a complete ZPL label format printing img scaled to the
+// label stock.
+func (c *Converter) Convert(img image.Image) ([]byte, error) {
+	width, err := c.dots(c.stockSize.Width)
+	if err != nil {
+		return nil, fmt.Errorf("stock width: %w", err)
+	}
+
+	height, err := c.dots(c.stockSize.Height)
+	if err != nil {
+		return nil, fmt.Errorf("stock height: %w", err)
+	}
+
+	gray := newGrayImage(img)
+
+	rotation := c.rotation
+	if rotation == 0 {
+		rotation = OrientationNormal
+		if (gray.width > gray.height) != (width > height) {
+			rotation = OrientationRotated
+		}
+	}
+
+	gray = gray.rotate(rotation)
+	gray = gray.fit(width, height)
+	bits := gray.dither(c.dithering, c.threshold)
+
+	var buf bytes.Buffer
+	buf.WriteString("^XA\n")
+	fmt.Fprintf(&buf, "^PW%d\n^LL%d\n", width, height)
+	buf.WriteString("^FO0,0")
+	buf.WriteString(GraphicField(bits, gray.width, gray.height))
+	buf.WriteString("^FS\n^XZ\n")
+
+	return buf.Bytes(), nil
+}
+
+func (c *Converter) dots(inches string) (int, error) {
+	v, err := strconv.ParseFloat(strings.TrimSpace(inches), 64)
+	if err != nil {
+		return 0, err
+	}
+
+	if v <= 0 {
+		return 0, fmt.Errorf("invalid size %q", inches)
+	}
+
+	return int(v * float64(c.dpi)), nil
+}
+
+// GraphicField returns a compressed ^GFA command for a 1-bit image. bits
+// contains one byte per pixel, row by row, where every value other than 0 is
+// printed black.
+func GraphicField(bits []byte, width, height int) string {
+	bytesPerRow := (width + 7) / 8
+
+	var data strings.Builder
+	var previous string
+	row := make([]byte, bytesPerRow)
+
+	for y := 0; y < height; y++ {
+		clear(row)
+		for x := 0; x < width; x++ {
+			if bits[y*width+x] != 0 {
+				row[x/8] |= 0x80 >> (x % 8)
+			}
+		}
+
+		line := compressRow(strings.ToUpper(fmt.Sprintf("%x", row)))
+		if y > 0 && line == previous {
+			data.WriteByte(':')
+			continue
+		}
+
+		data.WriteString(line)
+		previous = line
+	}
+
+	total := bytesPerRow * height
+
+	return fmt.Sprintf("^GFA,%d,%d,%d,%s", total, total, bytesPerRow, data.String())
+}
+
+// compressRow applies the ZPL ASCII compression to a hex encoded row.
+func compressRow(hex string) string {
+	trimmed := strings.TrimRight(hex, "0")
+	suffix := ""
+	if len(trimmed) < len(hex) {
+		suffix = ","
+	}
+
+	if trimmed == "" {
+		return ","
+	}
+
+	var b strings.Builder
+	for i := 0; i < len(trimmed); {
+		j := i
+		for j < len(trimmed) && trimmed[j] == trimmed[i] {
+			j++
+		}
+
+		for n := j - i; n > 0; {
+			chunk := min(n, 419)
+			b.WriteString(repeatCount(chunk))
+			b.WriteByte(trimmed[i])
+			n -= chunk
+		}
+
+		i = j
+	}
+
+	b.WriteString(suffix)
+
+	return b.String()
+}
+
+// repeatCount encodes n (1-419) as ZPL repeat characters: g-z are multiples
+// of 20, G-Y are 1 to 19.
+func repeatCount(n int) string {
+	if n == 1 {
+		return ""
+	}
+
+	var s string
+	if n >= 20 {
+		s += string(rune('g' + n/20 - 1))
+		n %= 20
+	}
+
+	if n > 0 {
+		s += string(rune('G' + n - 1))
+	}
+
+	return s
+}
+
+// grayImage is an 8-bit grayscale image where transparent pixels are white.
+type grayImage struct {
+	width, height int
+	pix           []float64
+}
+
+func newGrayImage(img image.Image) *grayImage {
+	bounds := img.Bounds()
+	g := &grayImage{
+		width:  bounds.Dx(),
+		height: bounds.Dy(),
+		pix:    make([]float64, bounds.Dx()*bounds.Dy()),
+	}
+
+	for y := 0; y < g.height; y++ {
+		for x := 0; x < g.width; x++ {
+			c := img.At(bounds.Min.X+x, bounds.Min.Y+y)
+
+			v := 255.0
+			if _, _, _, a := c.RGBA(); a >= 0x8000 {
+				v = float64(color.GrayModel.Convert(c).(color.Gray).Y)
+			}
+
+			g.pix[y*g.width+x] = v
+		}
+	}
+
+	return g
+}
+
+func (g *grayImage) at(x, y int) float64 {
+	return g.pix[y*g.width+x]
+}
+
+func (g *grayImage) rotate(orientation Orientation) *grayImage {
+	if orientation == OrientationNormal {
+		return g
+	}
+
+	r := &grayImage{width: g.width, height: g.height, pix: make([]float64, len(g.pix))}
+	if orientation != OrientationInverted {
+		r.width, r.height = g.height, g.width
+	}
+
+	for y := 0; y < g.height; y++ {
+		for x := 0; x < g.width; x++ {
+			var nx, ny int
+
+			switch orientation {
+			case OrientationRotated:
+				nx, ny = g.height-1-y, x
+			case OrientationInverted:
+				nx, ny = g.width-1-x, g.height-1-y
+			case OrientationBottomUp:
+				nx, ny = y, g.width-1-x
+			}
+
+			r.pix[ny*r.width+nx] = g.at(x, y)
+		}
+	}
+
+	return r
+}
+
+// fit scales the image to fit into width x height, keeping the aspect ratio,
+// using bilinear interpolation.
+func (g *grayImage) fit(width, height int) *grayImage {
+	scale := min(float64(width)/float64(g.width), float64(height)/float64(g.height))
+
+	r := &grayImage{
+		width:  max(1, int(float64(g.width)*scale)),
+		height: max(1, int(float64(g.height)*scale)),
+	}
+	r.pix = make([]float64, r.width*r.height)
+
+	for y := 0; y < r.height; y++ {
+		sy := min(max((float64(y)+0.5)/scale-0.5, 0), float64(g.height-1))
+		y0 := int(sy)
+		y1 := min(y0+1, g.height-1)
+		fy := sy - float64(y0)
+
+		for x := 0; x < r.width; x++ {
+			sx := min(max((float64(x)+0.5)/scale-0.5, 0), float64(g.width-1))
+			x0 := int(sx)
+			x1 := min(x0+1, g.width-1)
+			fx := sx - float64(x0)
+
+			top := g.at(x0, y0)*(1-fx) + g.at(x1, y0)*fx
+			bottom := g.at(x0, y1)*(1-fx) + g.at(x1, y1)*fx
+			r.pix[y*r.width+x] = top*(1-fy) + bottom*fy
+		}
+	}
+
+	return r
+}
+
+var bayer4x4 = [4][4]float64{
+	{0, 8, 2, 10},
+	{12, 4, 14, 6},
+	{3, 11, 1, 9},
+	{15, 7, 13, 5},
+}
+
+// dither returns one byte per pixel, 1 for black and 0 for white.
+func (g *grayImage) dither(dithering Dithering, threshold uint8) []byte {
+	bits := make([]byte, len(g.pix))
+
+	switch dithering {
+	case DitherFloydSteinberg:
+		pix := append([]float64(nil), g.pix...)
+
+		for y := 0; y < g.height; y++ {
+			for x := 0; x < g.width; x++ {
+				i := y*g.width + x
+
+				v := 255.0
+				if pix[i] < 128 {
+					v = 0
+					bits[i] = 1
+				}
+
+				e := pix[i] - v
+				if x+1 < g.width {
+					pix[i+1] += e * 7 / 16
+				}
+				if y+1 < g.height {
+					if x > 0 {
+						pix[i+g.width-1] += e * 3 / 16
+					}
+					pix[i+g.width] += e * 5 / 16
+					if x+1 < g.width {
+						pix[i+g.width+1] += e * 1 / 16
+					}
+				}
+			}
+		}
+	case DitherOrdered:
+		for y := 0; y < g.height; y++ {
+			for x := 0; x < g.width; x++ {
+				limit := (bayer4x4[y%4][x%4] + 0.5) * 16
+				if g.at(x, y) < limit {
+					bits[y*g.width+x] = 1
+				}
+			}
+		}
+	default:
+		for i, v := range g.pix {
+			if v < float64(threshold) {
+				bits[i] = 1
+			}
+		}
+	}
+
+	return bits
+}
@@ -0,0 +1,235 @@
+package zpl
+
+import (
+	"bytes"
+	"encoding/base64"
+	"image"
+	"image/color"
+	"image/gif"
+	"image/png"
+	"slices"
+	"strings"
+	"testing"
+
+	"github.com/enthus-golang/ups"
+)
+
+func TestRepeatCount(t *testing.T) {
+	tests := []struct {
+		n    int
+		want string
+	}{
+		{1, ""},
+		{2, "H"},
+		{19, "Y"},
+		{20, "g"},
+		{21, "gG"},
+		{40, "h"},
+		{400, "z"},
+		{419, "zY"},
+	}
+
+	for _, tt := range tests {
+		if got := repeatCount(tt.n); got != tt.want {
+			t.Errorf("repeatCount(%d) = %q, want %q", tt.n, got, tt.want)
+		}
+	}
+}
+
+func TestCompressRow(t *testing.T) {
+	tests := []struct {
+		name string
+		hex  string
+		want string
+	}{
+		{"repeated", "FFFF", "JF"},
+		{"trailing zeros", "F000", "F,"},
+		{"white row", "0000", ","},
+		{"no repeats", "0F0F", "0F0F"},
+		{"multiple of 20", strings.Repeat("A", 40), "hA"},
+		{"over 20", strings.Repeat("A", 25) + "00", "gKA,"},
+		{"over 419", strings.Repeat("F", 420), "zYFF"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := compressRow(tt.hex); got != tt.want {
+				t.Errorf("compressRow(%q) = %q, want %q", tt.hex, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestGraphicField(t *testing.T) {
+	tests := []struct {
+		name          string
+		bits          []byte
+		width, height int
+		want          string
+	}{
+		{"repeated row", bytes.Repeat([]byte{1}, 16), 8, 2, "^GFA,2,2,1,HF:"},
+		{"padded row", []byte{1, 0, 0, 0, 0, 0, 0, 0, 0, 1}, 10, 1, "^GFA,2,2,2,804,"},
+		{"white", make([]byte, 2), 1, 2, "^GFA,2,2,1,,:"},
+		{"changing rows", []byte{1, 0, 0, 1}, 2, 2, "^GFA,2,2,1,8,4,"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := GraphicField(tt.bits, tt.width, tt.height); got != tt.want {
+				t.Errorf("GraphicField() = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestRotate(t *testing.T) {
+	g := &grayImage{width: 2, height: 1, pix: []float64{0, 255}}
+
+	tests := []struct {
+		orientation   Orientation
+		width, height int
+		want          []float64
+	}{
+		{OrientationNormal, 2, 1, []float64{0, 255}},
+		{OrientationRotated, 1, 2, []float64{0, 255}},
+		{OrientationInverted, 2, 1, []float64{255, 0}},
+		{OrientationBottomUp, 1, 2, []float64{255, 0}},
+	}
+
+	for _, tt := range tests {
+		r := g.rotate(tt.orientation)
+		if r.width != tt.width || r.height != tt.height || !slices.Equal(r.pix, tt.want) {
+			t.Errorf("rotate(%c) = %dx%d %v, want %dx%d %v", tt.orientation, r.width, r.height, r.pix, tt.width, tt.height, tt.want)
+		}
+	}
+}
+
+func TestDither(t *testing.T) {
+	gray := &grayImage{width: 4, height: 4, pix: make([]float64, 16)}
+	for i := range gray.pix {
+		gray.pix[i] = 100
+	}
+
+	tests := []struct {
+		name      string
+		dithering Dithering
+		threshold uint8
+		wantBlack int
+	}{
+		{"threshold", DitherThreshold, 128, 16},
+		{"lower threshold", DitherThreshold, 64, 0},
+		{"ordered", DitherOrdered, 128, 10},
+		{"floyd steinberg", DitherFloydSteinberg, 128, 11},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			black := 0
+			for _, bit := range gray.dither(tt.dithering, tt.threshold) {
+				black += int(bit)
+			}
+
+			if black != tt.wantBlack {
+				t.Errorf("dither() = %d black pixels, want %d", black, tt.wantBlack)
+			}
+		})
+	}
+}
+
+func TestNewGrayImageTransparent(t *testing.T) {
+	img := image.NewNRGBA(image.Rect(0, 0, 2, 1))
+	img.Set(0, 0, color.NRGBA{A: 255})
+	img.Set(1, 0, color.NRGBA{})
+
+	if g := newGrayImage(img); !slices.Equal(g.pix, []float64{0, 255}) {
+		t.Errorf("newGrayImage() = %v, want black and white", g.pix)
+	}
+}
+
+func TestConvert(t *testing.T) {
+	img := image.NewGray(image.Rect(0, 0, 2, 1))
+
+	tests := []struct {
+		name    string
+		options []OptionFunction
+		want    string
+		wantErr bool
+	}{
+		{"rotated to stock", nil, "^XA\n^PW812\n^LL1218\n^FO0,0^GFA,93786,93786,77,", false},
+		{"fixed rotation", []OptionFunction{WithRotation(OrientationNormal)}, "^XA\n^PW812\n^LL1218\n^FO0,0^GFA,41412,41412,102,", false},
+		{"300 dpi", []OptionFunction{WithDPI(300), WithStockSize(ups.LabelStockSize{Height: "4", Width: "6"})}, "^XA\n^PW1800\n^LL1200\n^FO0,0^GFA,", false},
+		{"invalid stock size", []OptionFunction{WithStockSize(ups.LabelStockSize{Height: "6", Width: "0"})}, "", true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			got, err := NewConverter(tt.options...).Convert(img)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("Convert() error = %v, want error %t", err, tt.wantErr)
+			}
+
+			if err == nil && (!strings.HasPrefix(string(got), tt.want) || !strings.HasSuffix(string(got), "^FS\n^XZ\n")) {
+				t.Errorf("Convert() = %.80q, want prefix %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestConvertShippingLabel(t *testing.T) {
+	img := image.NewPaletted(image.Rect(0, 0, 8, 12), color.Palette{color.White, color.Black})
+
+	var gifData, pngData bytes.Buffer
+	if err := gif.Encode(&gifData, img, nil); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := png.Encode(&pngData, img); err != nil {
+		t.Fatal(err)
+	}
+
+	zplData := []byte("^XA^FO10,10^FDtest^FS^XZ")
+
+	tests := []struct {
+		name      string
+		label     ups.ShippingLabel
+		wantParts int
+		wantErr   bool
+	}{
+		{"gif", ups.ShippingLabel{ImageFormat: ups.ImageFormat{Code: "GIF"}, GraphicImage: encode(gifData.Bytes())}, 1, false},
+		{"png", ups.ShippingLabel{ImageFormat: ups.ImageFormat{Code: "PNG"}, GraphicImage: encode(pngData.Bytes())}, 1, false},
+		{"gif with part", ups.ShippingLabel{ImageFormat: ups.ImageFormat{Code: "GIF"}, GraphicImage: encode(gifData.Bytes()), GraphicImagePart: encode(gifData.Bytes())}, 2, false},
+		{"zpl", ups.ShippingLabel{ImageFormat: ups.ImageFormat{Code: "ZPL"}, GraphicImage: encode(zplData)}, 1, false},
+		{"epl", ups.ShippingLabel{ImageFormat: ups.ImageFormat{Code: "EPL"}, GraphicImage: encode([]byte("N\nP1\n"))}, 0, true},
+		{"invalid image", ups.ShippingLabel{ImageFormat: ups.ImageFormat{Code: "PNG"}, GraphicImage: encode([]byte("\x89PNG\r\n\x1a\n"))}, 0, true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			label, err := NewConverter().ConvertShippingLabel(&tt.label)
+			if (err != nil) != tt.wantErr {
+				t.Fatalf("ConvertShippingLabel() error = %v, want error %t", err, tt.wantErr)
+			}
+
+			if err != nil {
+				return
+			}
+
+			if label.Format() != ups.LabelFormatZPL {
+				t.Errorf("ConvertShippingLabel() format = %s, want ZPL", label.Format())
+			}
+
+			data, err := label.Decode()
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if got := bytes.Count(data, []byte("^XA")); got != tt.wantParts {
+				t.Errorf("ConvertShippingLabel() = %d label formats, want %d", got, tt.wantParts)
+			}
+		})
+	}
+}
+
+func encode(data []byte) string {
+	return base64.StdEncoding.EncodeToString(data)
+}
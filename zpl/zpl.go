@@ -0,0 +1,362 @@
+// Package zpl parses ZPL labels returned by UPS and adds custom fields to
+// them.
+package zpl
+
+import (
+	"bytes"
+	"encoding/base64"
+	"errors"
+	"fmt"
+	"image"
+	"strconv"
+	"strings"
+
+	"github.com/enthus-golang/ups"
+)
+
+var (
+	// ErrNoLabelFormat is returned if the data contains no ^XA ... ^XZ label
+	// format.
+	ErrNoLabelFormat = errors.New("no label format found")
+	// ErrOverlap is returned if an inserted field overlaps a barcode of the
+	// label.
+	ErrOverlap = errors.New("field overlaps barcode")
+)
+
+// Command is a single ZPL command. Parameters contains everything up to the
+// next command, including field data and whitespace, so that a parsed label
+// is written back unchanged.
+type Command struct {
+	// Prefix is the prefix the command was written with, '^' or '~' unless
+	// changed by ^CC or ^CT. Text before the first command has no prefix.
+	Prefix byte
+	// Control is true for commands using the control prefix, e.g. ~DG.
+	Control bool
+	// Name is the two character command name, e.g. FO or BC. For font
+	// commands the font name is part of the command name, e.g. A0.
+	Name       string
+	Parameters string
+}
+
+func (c Command) String() string {
+	if c.Prefix == 0 {
+		return c.Parameters
+	}
+
+	return string(c.Prefix) + c.Name + c.Parameters
+}
+
+// Is reports whether c is the command ^name or ~name.
+func (c Command) Is(name string) bool {
+	return c.Prefix != 0 && strings.EqualFold(c.Name, name)
+}
+
+func (c Command) params() []string {
+	return strings.Split(strings.TrimSpace(c.Parameters), ",")
+}
+
+func (c Command) intParam(i, fallback int) int {
+	params := c.params()
+	if i >= len(params) {
+		return fallback
+	}
+
+	v, err := strconv.Atoi(strings.TrimSpace(params[i]))
+	if err != nil {
+		return fallback
+	}
+
+	return v
+}
+
+// Label is a parsed ZPL document.
+type Label struct {
+	Commands []Command
+}
+
+// Default prefixes of format and control commands.
+const (
+	defaultFormatPrefix  = '^'
+	defaultControlPrefix = '~'
+)
+
+// Parse splits ZPL data into commands. Field data of ^FD and ^FV is read up to
+// the next ^FS, so that it may contain the control prefix. Prefixes changed
+// by ^CC and ^CT are honored.
+func Parse(data []byte) (*Label, error) {
+	label := &Label{}
+
+	format, control := byte(defaultFormatPrefix), byte(defaultControlPrefix)
+
+	start := 0
+	for i := 0; i < len(data); {
+		if data[i] != format && data[i] != control {
+			i++
+			continue
+		}
+
+		if i > start {
+			label.appendText(data[start:i])
+		}
+
+		end := min(i+3, len(data))
+		c := Command{
+			Prefix:  data[i],
+			Control: data[i] == control && control != format,
+			Name:    string(data[i+1 : end]),
+		}
+
+		var next int
+
+		switch {
+		case !c.Control && (c.Is("FD") || c.Is("FV")):
+			next = indexFieldSeparator(data, end, format)
+		case (c.Is("CC") || c.Is("CT")) && end < len(data):
+			// The parameter is the new prefix character.
+			next = end + 1
+
+			if c.Is("CC") {
+				format = data[end]
+			} else {
+				control = data[end]
+			}
+		default:
+			next = len(data)
+			if j := bytes.IndexAny(data[end:], string([]byte{format, control})); j >= 0 {
+				next = end + j
+			}
+		}
+
+		c.Parameters = string(data[end:next])
+		label.Commands = append(label.Commands, c)
+
+		start, i = next, next
+	}
+
+	if start < len(data) {
+		label.appendText(data[start:])
+	}
+
+	if label.lastIndex("XZ") < 0 || label.lastIndex("XA") < 0 {
+		return nil, ErrNoLabelFormat
+	}
+
+	return label, nil
+}
+
+// indexFieldSeparator returns the index of the ^FS following the field data
+// starting at start, or the end of data if there is none.
+func indexFieldSeparator(data []byte, start int, format byte) int {
+	for i := start; i+2 < len(data); i++ {
+		if data[i] == format && (data[i+1] == 'F' || data[i+1] == 'f') && (data[i+2] == 'S' || data[i+2] == 's') {
+			return i
+		}
+	}
+
+	return len(data)
+}
+
+// prefixes returns the format and control prefix in effect before the
+// command at index.
+func (l *Label) prefixes(index int) (format, control byte) {
+	format, control = defaultFormatPrefix, defaultControlPrefix
+
+	for _, c := range l.Commands[:index] {
+		if len(c.Parameters) == 0 {
+			continue
+		}
+
+		switch {
+		case c.Is("CC"):
+			format = c.Parameters[0]
+		case c.Is("CT"):
+			control = c.Parameters[0]
+		}
+	}
+
+	return format, control
+}
+
+// ParseShippingLabel decodes and parses a ZPL shipping label.
+func ParseShippingLabel(label *ups.ShippingLabel) (*Label, error) {
+	if format := label.Format(); format != ups.LabelFormatZPL {
+		return nil, fmt.Errorf("label format is %s, not ZPL", format)
+	}
+
+	data, err := label.Decode()
+	if err != nil {
+		return nil, err
+	}
+
+	return Parse(data)
+}
+
+func (l *Label) appendText(text []byte) {
+	l.Commands = append(l.Commands, Command{Parameters: string(text)})
+}
+
+func (l *Label) lastIndex(name string) int {
+	for i := len(l.Commands) - 1; i >= 0; i-- {
+		if l.Commands[i].Is(name) {
+			return i
+		}
+	}
+
+	return -1
+}
+
+// Bytes returns the label as ZPL.
+func (l *Label) Bytes() []byte {
+	var buf bytes.Buffer
+
+	for _, c := range l.Commands {
+		buf.WriteString(c.String())
+	}
+
+	return buf.Bytes()
+}
+
+// Insert adds the fields to the end of the last label format, right before
+// ^XZ. ErrOverlap is returned if a field would cover one of the barcodes of
+// the label, e.g. the MaxiCode or the 1Z barcode.
+func (l *Label) Insert(fields ...Field) error {
+	barcodes := l.Barcodes()
+
+	var commands []Command
+	for _, field := range fields {
+		bounds := field.Bounds()
+		for _, barcode := range barcodes {
+			if bounds.Overlaps(barcode.Bounds) {
+				return fmt.Errorf("%w: %s at %v", ErrOverlap, barcode.Type, barcode.Bounds)
+			}
+		}
+
+		commands = append(commands, field.Commands()...)
+	}
+
+	end := l.lastIndex("XZ")
+	if end < 0 {
+		return ErrNoLabelFormat
+	}
+
+	// The fields are written with the default prefixes, which may have been
+	// changed by the label.
+	format, control := l.prefixes(end)
+	for i := range commands {
+		c := &commands[i]
+
+		switch {
+		case c.Control || c.Prefix == defaultControlPrefix:
+			c.Prefix, c.Control = control, true
+		case c.Prefix != 0:
+			c.Prefix = format
+		}
+
+		if format != defaultFormatPrefix && c.Is("FD") {
+			c.Parameters = strings.ReplaceAll(c.Parameters, string(format), fmt.Sprintf("_%02X", format))
+		}
+	}
+
+	l.Commands = append(l.Commands[:end], append(commands, l.Commands[end:]...)...)
+
+	return nil
+}
+
+// UpdateShippingLabel replaces the graphic image of label with l.
+func (l *Label) UpdateShippingLabel(label *ups.ShippingLabel) {
+	label.GraphicImage = base64.StdEncoding.EncodeToString(l.Bytes())
+	label.GraphicImagePart = ""
+}
+
+// Barcode is a barcode field of a parsed label.
+type Barcode struct {
+	// Type is the barcode command, e.g. BC for Code 128 or BD for MaxiCode.
+	Type string
+	Data string
+	// Bounds is the estimated area covered by the barcode in dots,
+	// including the interpretation line.
+	Bounds image.Rectangle
+}
+
+// Barcodes returns all barcode fields of the label with their estimated
+// position and size.
+func (l *Label) Barcodes() []Barcode {
+	var (
+		barcodes    []Barcode
+		x, y        int
+		typeset     bool
+		moduleWidth = 2
+		barHeight   = 10
+		orientation = OrientationNormal
+		current     *Command
+		data        string
+	)
+
+	for i := range l.Commands {
+		c := l.Commands[i]
+
+		switch {
+		case c.Prefix == 0:
+		case c.Is("FO"), c.Is("FT"):
+			x = c.intParam(0, 0)
+			y = c.intParam(1, 0)
+			typeset = c.Is("FT")
+		case c.Is("BY"):
+			moduleWidth = c.intParam(0, moduleWidth)
+			barHeight = c.intParam(2, barHeight)
+		case c.Is("FW"):
+			orientation = parseOrientation(c.params()[0], orientation)
+		case !c.Control && len(c.Name) == 2 && c.Name[0] == 'B' && !c.Is("BY"):
+			current = &l.Commands[i]
+		case c.Is("FD"):
+			data = c.Parameters
+		case c.Is("FS"):
+			if current != nil {
+				bounds := barcodeBounds(*current, data, x, y, moduleWidth, barHeight, orientation)
+				if typeset {
+					// ^FT positions the lower left corner of the field.
+					bounds = bounds.Sub(image.Pt(0, bounds.Dy()))
+				}
+
+				barcodes = append(barcodes, Barcode{
+					Type:   current.Name,
+					Data:   data,
+					Bounds: bounds,
+				})
+			}
+
+			current = nil
+			data = ""
+		}
+	}
+
+	return barcodes
+}
+
+func barcodeBounds(c Command, data string, x, y, moduleWidth, barHeight int, orientation Orientation) image.Rectangle {
+	var width, height int
+
+	switch strings.ToUpper(c.Name) {
+	case "BD":
+		// MaxiCode has a fixed size of about 1.11 x 1.05 inch.
+		width, height = 230, 220
+	case "BC":
+		orientation = parseOrientation(c.params()[0], orientation)
+		height = c.intParam(1, barHeight)
+		// start, stop and check character plus the data, 11 modules each,
+		// and the 2 module termination bar.
+		width = (11*(len(data)+3) + 2) * moduleWidth
+		if params := c.params(); len(params) < 3 || strings.TrimSpace(params[2]) != "N" {
+			height += 30
+		}
+	default:
+		height = c.intParam(1, barHeight)
+		width = 11 * (len(data) + 3) * moduleWidth
+	}
+
+	if orientation == OrientationRotated || orientation == OrientationBottomUp {
+		width, height = height, width
+	}
+
+	return image.Rect(x, y, x+width, y+height)
+}
@@ -0,0 +1,244 @@
+package zpl
+
+import (
+	"bytes"
+	"errors"
+	"image"
+	"os"
+	"strings"
+	"testing"
+)
+
+func readLabel(t *testing.T) []byte {
+	t.Helper()
+
+	data, err := os.ReadFile("testdata/ups_ground.zpl")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	return data
+}
+
+func TestParseRoundTrip(t *testing.T) {
+	tests := []struct {
+		name string
+		data []byte
+	}{
+		{"ups label", nil},
+		{"text before first command", []byte("\r\n^XA^FO10,10^FDtest^FS^XZ\r\n")},
+		{"control prefix in field data", []byte("^XA^FO10,10^FD~JA ~DG^FS^XZ")},
+		{"changed format prefix", []byte("^XA^CC+\n+FO10,10+FDA^B+FS+XZ")},
+		{"changed control prefix", []byte("^XA^CT#^FO10,10^FD~#^FS#JA^XZ")},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			data := tt.data
+			if data == nil {
+				data = readLabel(t)
+			}
+
+			label, err := Parse(data)
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if got := label.Bytes(); !bytes.Equal(got, data) {
+				t.Errorf("Bytes() = %q, want %q", got, data)
+			}
+		})
+	}
+}
+
+func TestParseFieldData(t *testing.T) {
+	tests := []struct {
+		name string
+		data string
+		want []string
+	}{
+		{"control prefix", "^XA^FD123 MAIN ST ~ SUITE 5^FS^XZ", []string{"123 MAIN ST ~ SUITE 5"}},
+		{"lower case separator", "^XA^FDa~b^fs^XZ", []string{"a~b"}},
+		{"field variable", "^XA^FVa~b^FS^XZ", []string{"a~b"}},
+		{"changed format prefix", "^XA^CC+\n+FDA^B~C+FS+XZ", []string{"A^B~C"}},
+		{"changed control prefix", "^XA^CT#^FDa#b^FS^XZ", []string{"a#b"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			label, err := Parse([]byte(tt.data))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			var got []string
+			for _, c := range label.Commands {
+				if c.Is("FD") || c.Is("FV") {
+					got = append(got, c.Parameters)
+				}
+			}
+
+			if strings.Join(got, "|") != strings.Join(tt.want, "|") {
+				t.Errorf("field data = %q, want %q", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParsePrefixes(t *testing.T) {
+	label, err := Parse([]byte("^XA^CC+\n+FO1,2+FS~JA~CT#+XZ#JA"))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	want := []Command{
+		{Prefix: '^', Name: "XA"},
+		{Prefix: '^', Name: "CC", Parameters: "+"},
+		{Parameters: "\n"},
+		{Prefix: '+', Name: "FO", Parameters: "1,2"},
+		{Prefix: '+', Name: "FS"},
+		{Prefix: '~', Control: true, Name: "JA"},
+		{Prefix: '~', Control: true, Name: "CT", Parameters: "#"},
+		{Prefix: '+', Name: "XZ"},
+		{Prefix: '#', Control: true, Name: "JA"},
+	}
+
+	if len(label.Commands) != len(want) {
+		t.Fatalf("Parse() = %+v, want %+v", label.Commands, want)
+	}
+
+	for i := range want {
+		if label.Commands[i] != want[i] {
+			t.Errorf("command %d = %+v, want %+v", i, label.Commands[i], want[i])
+		}
+	}
+}
+
+func TestParseNoLabelFormat(t *testing.T) {
+	for _, data := range []string{"", "text", "^XA^FDtest^FS", "^FDtest^FS^XZ", "^XA^FD^XZ"} {
+		if _, err := Parse([]byte(data)); !errors.Is(err, ErrNoLabelFormat) {
+			t.Errorf("Parse(%q) error = %v, want %v", data, err, ErrNoLabelFormat)
+		}
+	}
+}
+
+func TestBarcodes(t *testing.T) {
+	label, err := Parse(readLabel(t))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	want := []Barcode{
+		{
+			Type:   "BD",
+			Data:   "001840303090000[)>_1E01_1D961Z12345678_1DUPSN_1D123X56_1D001_1D_1D1/1_1D1_1DN_1D1000 PEACHTREE ST NE_1DATLANTA_1DGA_1E_04",
+			Bounds: image.Rect(19, 424, 249, 644),
+		},
+		{
+			Type:   "BC",
+			Data:   ">;4203030909",
+			Bounds: image.Rect(250, 540, 250+(11*15+2)*3, 643),
+		},
+		{
+			Type:   "BC",
+			Data:   "1Z123X560312345678",
+			Bounds: image.Rect(45, 800, 45+(11*21+2)*3, 1003),
+		},
+	}
+
+	got := label.Barcodes()
+	if len(got) != len(want) {
+		t.Fatalf("Barcodes() = %+v, want %+v", got, want)
+	}
+
+	for i := range want {
+		if got[i] != want[i] {
+			t.Errorf("Barcodes()[%d] = %+v, want %+v", i, got[i], want[i])
+		}
+	}
+}
+
+func TestInsert(t *testing.T) {
+	tests := []struct {
+		name   string
+		data   string
+		fields []Field
+		want   string
+	}{
+		{
+			name:   "text",
+			data:   "^XA^FO10,10^FDa^FS^XZ",
+			fields: []Field{Text{X: 10, Y: 100, Text: "REF ^1_~", Height: 20}},
+			want:   "^XA^FO10,10^FDa^FS^FO10,100^A0N,20,20^FH^FDREF _5E1_5F_7E^FS^XZ",
+		},
+		{
+			name:   "last label format",
+			data:   "^XA^XZ\n^XA^XZ\n",
+			fields: []Field{Code128{X: 10, Y: 10, Data: "4711", Height: 50}},
+			want:   "^XA^XZ\n^XA^FO10,10^BY2^BCN,50,Y,N,N^FH^FD4711^FS^XZ\n",
+		},
+		{
+			name:   "changed format prefix",
+			data:   "^XA^CC+\n+FO10,10+FDa+FS+XZ",
+			fields: []Field{Text{X: 10, Y: 100, Text: "1+1", Height: 20}},
+			want:   "^XA^CC+\n+FO10,10+FDa+FS+FO10,100+A0N,20,20+FH+FD1_2B1+FS+XZ",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			label, err := Parse([]byte(tt.data))
+			if err != nil {
+				t.Fatalf("Parse() error = %v", err)
+			}
+
+			if err := label.Insert(tt.fields...); err != nil {
+				t.Fatalf("Insert() error = %v", err)
+			}
+
+			if got := string(label.Bytes()); got != tt.want {
+				t.Errorf("Bytes() = %q, want %q", got, tt.want)
+			}
+
+			if _, err := Parse(label.Bytes()); err != nil {
+				t.Errorf("Parse() of the result error = %v", err)
+			}
+		})
+	}
+}
+
+func TestInsertOverlap(t *testing.T) {
+	label, err := Parse(readLabel(t))
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+
+	data := label.Bytes()
+
+	tests := []struct {
+		name  string
+		field Field
+		want  error
+	}{
+		{"maxicode", Text{X: 100, Y: 500, Text: "X", Height: 20}, ErrOverlap},
+		{"tracking barcode", Code128{X: 0, Y: 900, Data: "1", Height: 20}, ErrOverlap},
+		{"free area", Text{X: 12, Y: 1100, Text: "REF 2: 4711", Height: 20}, nil},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			label, err := Parse(data)
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			if err := label.Insert(tt.field); !errors.Is(err, tt.want) {
+				t.Errorf("Insert() error = %v, want %v", err, tt.want)
+			}
+
+			if tt.want != nil && !bytes.Equal(label.Bytes(), data) {
+				t.Errorf("Insert() changed the label after an error")
+			}
+		})
+	}
+}